@@ -1,9 +1,24 @@
 package collection
 
+import (
+    "fmt"
+    "reflect"
+    "strings"
+)
+
 const ElementNotFound = -1
 
+// Unbounded is the capacity reported for Collections that are not Bounded.
+const Unbounded = -1
+
+// StreamBufferSize is the size of the buffer of the channels returned by Stream.
+const StreamBufferSize = 64
+
 const (
     ErrorElementNotFound = CollectionError("the requested element could not be found")
+    ErrorImmutable       = CollectionError("the collection cannot be modified")
+    ErrorEmpty           = CollectionError("the collection contains no elements")
+    ErrorCapacityReached = CollectionError("the collection has reached capacity")
 )
 
 type CollectionError string
@@ -20,14 +35,18 @@ type Collection interface {
     Add(element interface{}) error
 
     // AddAll inserts all elements from the provided collection into the Collection. The returned error will be non-nil
-    // for bounded Collection implementations that have reached capacity and cannot hold any further elements.
+    // for bounded Collection implementations that have reached capacity and cannot hold any further elements. AddAll is
+    // all-or-nothing: if the returned error is non-nil, the Collection is left unchanged. The elements of the provided
+    // collection are read before any of them are inserted, so a Collection may be added to itself, which inserts each
+    // of its elements once more (e.g. doubling the size of a List).
     AddAll(collection Collection) error
 
     // Remove removes the first occurrence (if any) of an element equivalent to the provided element. If an element was
     // removed, the return value will be true, otherwise false will be returned.
     Remove(element interface{}) bool
 
-    // Size returns the number of elements in the Collection.
+    // Size returns the number of elements in the Collection. Every implementation of Collection in this module keeps
+    // track of its number of elements, so Size is O(1).
     Size() int
 
     // IsEmpty returns true if the Collection contains no elements, otherwise false is returned.
@@ -42,6 +61,38 @@ type Collection interface {
 
     // Values returns a slice containing the elements in the Collection in the iteration order.
     Values() []interface{}
+
+    // Clone returns a new Collection of the same kind containing the elements of the Collection, so that elements can be
+    // added to or removed from either Collection without affecting the other. The copy is shallow: the elements
+    // themselves are not copied, so elements that refer to other values (e.g. pointers, slices, and maps) are shared.
+    Clone() Collection
+
+    // ContainsAll returns true if every element of the provided collection exists in the Collection, otherwise false is
+    // returned. A nil or empty collection is contained by every Collection.
+    ContainsAll(other Collection) bool
+
+    // RemoveAll removes every occurrence of each element of the provided collection from the Collection, and returns the
+    // number of elements removed. A nil collection removes no elements.
+    RemoveAll(other Collection) int
+
+    // RetainAll removes every element from the Collection that does not exist in the provided collection, and returns
+    // the number of elements removed. A nil collection is treated as an empty collection, so all elements are removed.
+    RetainAll(other Collection) int
+
+    // Iterator returns an Iterator positioned before the first element of the Collection in iteration order.
+    Iterator() Iterator
+}
+
+// Iterator defines the behavior for traversing the elements of a Collection in iteration order without materializing
+// them, so that a traversal may be ended early.
+type Iterator interface {
+
+    // HasNext returns true if the traversal has further elements, otherwise false is returned.
+    HasNext() bool
+
+    // Next advances the traversal and returns the next element. If the traversal has no further elements, the return
+    // value will be nil.
+    Next() interface{}
 }
 
 // Ordered defines the behavior for a Collection whose elements are algorithmically positioned.
@@ -63,4 +114,229 @@ type Ordered interface {
     // Successor returns the element (if any) from the Collection that is greater than the provided element. More
     // specifically, the element after the first occurrence of the provided element in iteration order is returned.
     Successor(element interface{}) interface{}
-}
\ No newline at end of file
+
+    // Floor returns the element (if any) from the Collection that is less than or equal to the provided element. Unlike
+    // Predecessor, if the provided element exists in the Collection it is returned.
+    Floor(element interface{}) interface{}
+
+    // Ceiling returns the element (if any) from the Collection that is greater than or equal to the provided element.
+    // Unlike Successor, if the provided element exists in the Collection it is returned.
+    Ceiling(element interface{}) interface{}
+}
+
+// Bounded defines the behavior for a Collection that can hold a limited number of elements. Collections that do not
+// implement Bounded are unbounded.
+type Bounded interface {
+    Collection
+
+    // Capacity returns the maximum number of elements the Collection can hold.
+    Capacity() int
+
+    // IsFull returns true if the Collection holds as many elements as its capacity, otherwise false is returned.
+    IsFull() bool
+}
+
+// Capacity returns the capacity of the provided Collection if it is Bounded, otherwise Unbounded is returned.
+func Capacity(c Collection) int {
+    if b, ok := c.(Bounded); ok {
+        return b.Capacity()
+    }
+
+    return Unbounded
+}
+
+// IsFull returns true if the provided Collection is Bounded and has reached capacity, otherwise false is returned.
+func IsFull(c Collection) bool {
+    if b, ok := c.(Bounded); ok {
+        return b.IsFull()
+    }
+
+    return false
+}
+
+// ContainsAll returns true if every element of the other Collection exists in the provided Collection, otherwise false
+// is returned. A nil or empty other Collection is contained by every Collection. Implementations of
+// Collection.ContainsAll without a more efficient approach delegate to this function.
+func ContainsAll(c Collection, other Collection) bool {
+    if other == nil {
+        return true
+    }
+
+    for _, v := range other.Values() {
+        if !c.Contains(v) {
+            return false
+        }
+    }
+
+    return true
+}
+
+// RemoveAll removes every occurrence of each element of the other Collection from the provided Collection, and returns
+// the number of elements removed. A nil other Collection removes no elements. Implementations of Collection.RemoveAll
+// without a more efficient approach delegate to this function.
+func RemoveAll(c Collection, other Collection) int {
+    if other == nil {
+        return 0
+    }
+
+    count := 0
+    for _, v := range other.Values() {
+        for c.Remove(v) {
+            count++
+        }
+    }
+
+    return count
+}
+
+// RetainAll removes every element from the provided Collection that does not exist in the other Collection, and returns
+// the number of elements removed. A nil other Collection is treated as an empty Collection. Implementations of
+// Collection.RetainAll without a more efficient approach delegate to this function.
+func RetainAll(c Collection, other Collection) int {
+    count := 0
+    for _, v := range c.Values() {
+        if other == nil || !other.Contains(v) {
+            for c.Remove(v) {
+                count++
+            }
+        }
+    }
+
+    return count
+}
+
+// ContainsAllOf returns a map from each of the provided elements to true if it exists in the provided Collection,
+// otherwise false. Elements that are not hashable (see IsHashable) cannot be keys of the map, and are omitted.
+// Implementations of Collection without a more efficient approach delegate to this function.
+func ContainsAllOf(c Collection, elements []interface{}) map[interface{}]bool {
+    membership := make(map[interface{}]bool, len(elements))
+    for _, element := range elements {
+        if IsHashable(element) {
+            if _, ok := membership[element]; !ok {
+                membership[element] = c.Contains(element)
+            }
+        }
+    }
+
+    return membership
+}
+
+// IsHashable returns true if the provided element can be used as a key of a map, and equality of its keys agrees with
+// reflect.DeepEqual, otherwise false is returned. Pointers are excluded since reflect.DeepEqual compares the values they
+// refer to.
+func IsHashable(element interface{}) bool {
+    return element == nil || isHashableType(reflect.TypeOf(element))
+}
+
+func isHashableType(t reflect.Type) bool {
+    switch t.Kind() {
+    case reflect.Array:
+        return isHashableType(t.Elem())
+    case reflect.Struct:
+        for i := 0; i < t.NumField(); i++ {
+            if !isHashableType(t.Field(i).Type) {
+                return false
+            }
+        }
+
+        return true
+    case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
+        return false
+    default:
+        return true
+    }
+}
+
+// Stream returns a channel over which the elements of the provided Collection are sent in iteration order by a producer
+// goroutine, which closes the channel once every element has been sent. The producer stops without sending the
+// remaining elements and closes the channel once the provided done channel is closed, so a caller that stops receiving
+// before the channel is closed must close the done channel, otherwise the producer is blocked indefinitely. A nil done
+// channel is never closed. The Collection must not be modified until the channel is closed, unless its Iterator
+// traverses a snapshot (e.g. that of a SynchronizedList).
+func Stream(c Collection, done <-chan struct{}) <-chan interface{} {
+    elements := make(chan interface{}, StreamBufferSize)
+
+    go func() {
+        defer close(elements)
+
+        for iterator := c.Iterator(); iterator.HasNext(); {
+            select {
+            case elements <- iterator.Next():
+            case <-done:
+                return
+            }
+        }
+    }()
+
+    return elements
+}
+
+// Format returns the string representation shared by the Collection implementations, in which the elements of the
+// provided Collection are formatted with the %v verb of fmt in iteration order, separated by ", ", and enclosed in
+// brackets (e.g. "[a, b, c]"). An empty Collection is formatted as "[]".
+func Format(c Collection) string {
+    if c.IsEmpty() {
+        return "[]"
+    }
+
+    elements := make([]string, 0, c.Size())
+    for iterator := c.Iterator(); iterator.HasNext(); {
+        elements = append(elements, fmt.Sprintf("%v", iterator.Next()))
+    }
+
+    return "[" + strings.Join(elements, ", ") + "]"
+}
+
+// Equals returns true if the provided Collections contain the same elements, otherwise false is returned. The
+// comparison is insensitive to the iteration order and the implementation of either Collection, but sensitive to the
+// number of occurrences of each element (i.e. the Collections are compared as multisets), where elements are compared
+// using reflect.DeepEqual. The occurrences of hashable elements (see IsHashable) are counted with a map, while the
+// remaining elements are matched pairwise, which is O(n²) in the number of elements that are not hashable. Two nil
+// Collections are equal, while a nil Collection is not equal to a non-nil Collection.
+func Equals(a Collection, b Collection) bool {
+    if a == nil || b == nil {
+        return a == nil && b == nil
+    }
+
+    valuesOfA, valuesOfB := a.Values(), b.Values()
+    if len(valuesOfA) != len(valuesOfB) {
+        return false
+    }
+
+    counts     := make(map[interface{}]int, len(valuesOfA))
+    unhashable := make([]interface{}, 0)
+    for _, v := range valuesOfA {
+        if IsHashable(v) {
+            counts[v]++
+        } else {
+            unhashable = append(unhashable, v)
+        }
+    }
+
+    matched := make([]bool, len(unhashable))
+    for _, w := range valuesOfB {
+        if IsHashable(w) {
+            if counts[w] == 0 {
+                return false
+            }
+            counts[w]--
+
+            continue
+        }
+
+        found := false
+        for i, v := range unhashable {
+            if !matched[i] && reflect.DeepEqual(v, w) {
+                matched[i] = true
+                found      = true
+                break
+            }
+        }
+
+        if !found {
+            return false
+        }
+    }
+
+    return true
+}
@@ -0,0 +1,188 @@
+package collection_test
+
+import (
+    "fmt"
+    "testing"
+
+    "github.com/2speed/go-collection"
+    "github.com/2speed/go-collection/list"
+    "github.com/2speed/go-collection/queue"
+    "github.com/2speed/go-collection/trie"
+)
+
+func TestEquals(t *testing.T) {
+    words := trie.NewTrie(26)
+    _ = words.AddAll(list.NewArrayListOf([]interface{}{ "yoshi", "samus", "luffy" }))
+
+    for _, c := range []struct {
+        name     string
+        a        collection.Collection
+        b        collection.Collection
+        expected bool
+    }{
+        { name: "ListAndTrie",  a: list.NewArrayListOf([]interface{}{ "luffy", "yoshi", "samus" }),   b: words,                                                             expected: true },
+        { name: "ListAndList",  a: list.NewArrayListOf([]interface{}{ 1, 2, 2 }),                     b: list.NewLinkedListOf([]interface{}{ 2, 1, 2 }),                    expected: true },
+        { name: "Multiplicity", a: list.NewArrayListOf([]interface{}{ 1, 2, 2 }),                     b: list.NewArrayListOf([]interface{}{ 1, 1, 2 }),                     expected: false },
+        { name: "Size",         a: list.NewArrayListOf([]interface{}{ "luffy", "yoshi" }),            b: words,                                                             expected: false },
+        { name: "Different",    a: list.NewArrayListOf([]interface{}{ "luffy", "yoshi", "kirby" }),   b: words,                                                             expected: false },
+        { name: "DeepEqual",    a: list.NewArrayListOf([]interface{}{ []byte("ab"), []int{ 1, 2 } }), b: list.NewArrayListOf([]interface{}{ []int{ 1, 2 }, []byte("ab") }), expected: true },
+        { name: "Empty",        a: list.NewArrayList(),                                               b: trie.NewTrie(26),                                                  expected: true },
+        { name: "Nil",          a: nil,                                                               b: nil,                                                               expected: true },
+        { name: "NilAndEmpty",  a: nil,                                                               b: list.NewArrayList(),                                               expected: false },
+    } {
+        if actual := collection.Equals(c.a, c.b); actual != c.expected {
+            t.Errorf("%s: expected '%t', but found '%t'", c.name, c.expected, actual)
+        }
+
+        if actual := collection.Equals(c.b, c.a); actual != c.expected {
+            t.Errorf("%s (reversed): expected '%t', but found '%t'", c.name, c.expected, actual)
+        }
+    }
+}
+
+func TestFormat(t *testing.T) {
+    byString := func(a, b interface{}) bool { return a.(string) < b.(string) }
+
+    for _, values := range [][]interface{}{
+        {},
+        { "luffy" },
+        { "luffy", "samus", "yoshi" },
+    } {
+        words := trie.NewTrie(26)
+        _ = words.AddAll(list.NewArrayListOf(values))
+
+        sortedList := list.NewSortedList(byString)
+        _ = sortedList.AddAll(list.NewArrayListOf(values))
+
+        priorityQueue := queue.NewPriorityQueue(byString)
+        _ = priorityQueue.AddAll(list.NewArrayListOf(values))
+
+        expected := collection.Format(list.NewArrayListOf(values))
+        for _, c := range []collection.Collection{
+            words,
+            sortedList,
+            priorityQueue,
+            list.NewLinkedListOf(values),
+            list.NewImmutableList(list.NewArrayListOf(values)),
+        } {
+            if actual := fmt.Sprintf("%v", c); actual != expected {
+                t.Errorf("%T: expected '%s', but found '%s'", c, expected, actual)
+            }
+        }
+    }
+
+    if actual := collection.Format(trie.NewTrie(26)); actual != "[]" {
+        t.Errorf("expected '[]', but found '%s'", actual)
+    }
+
+    if actual := collection.Format(list.NewArrayListOf([]interface{}{ 1, "a", nil })); actual != "[1, a, <nil>]" {
+        t.Errorf("expected '[1, a, <nil>]', but found '%s'", actual)
+    }
+}
+
+func TestClone(t *testing.T) {
+    values   := []interface{}{ "luffy", "samus", "yoshi" }
+    byString := func(a, b interface{}) bool { return a.(string) < b.(string) }
+
+    for _, source := range []collection.Collection{
+        list.NewArrayList(),
+        list.NewLinkedList(),
+        list.NewCircularList(4),
+        list.NewIndexedArrayList(),
+        list.NewCopyOnWriteList(),
+        list.NewSynchronizedList(list.NewArrayList()),
+        list.NewSortedList(byString),
+        queue.NewPriorityQueue(byString),
+        trie.NewTrie(26),
+        trie.NewRadixTree(26),
+        trie.NewCountingTrie(26),
+        trie.NewTrieMap(26),
+    } {
+        _ = source.AddAll(list.NewArrayListOf(values))
+
+        clone := source.Clone()
+        if expected, actual := fmt.Sprintf("%T", source), fmt.Sprintf("%T", clone); actual != expected {
+            t.Errorf("expected clone of type '%s', but found '%s'", expected, actual)
+        }
+        if actual := collection.Format(clone); actual != "[luffy, samus, yoshi]" {
+            t.Errorf("%T: expected clone '[luffy, samus, yoshi]', but found '%s'", source, actual)
+        }
+
+        _ = clone.Add("kirby")
+        clone.Remove("luffy")
+
+        if actual := collection.Format(source); actual != "[luffy, samus, yoshi]" {
+            t.Errorf("%T: expected source '[luffy, samus, yoshi]', but found '%s'", source, actual)
+        }
+        if !clone.Contains("kirby") || clone.Contains("luffy") || clone.Size() != 3 {
+            t.Errorf("%T: expected clone to be modified independently, but found '%s'", source, collection.Format(clone))
+        }
+    }
+
+    t.Run("Immutable", func(t *testing.T) {
+        source := list.NewImmutableList(list.NewArrayListOf(values))
+        if clone := source.Clone(); clone != source {
+            t.Errorf("expected ImmutableList to be its own clone, but found '%v'", clone)
+        }
+    })
+
+    t.Run("Observable", func(t *testing.T) {
+        source, observe := list.NewObservableList(list.NewArrayListOf(values))
+        observe(func(event list.MutationEvent) { t.Errorf("expected no events, but found '%v'", event) })
+
+        clone := source.Clone()
+        if _, ok := clone.(list.List); !ok || collection.Format(clone) != "[luffy, samus, yoshi]" {
+            t.Errorf("expected a List clone '[luffy, samus, yoshi]', but found '%v'", clone)
+        }
+        _ = clone.Add("kirby")
+    })
+
+    t.Run("Shallow", func(t *testing.T) {
+        element := map[string]int{ "lives": 3 }
+        source  := list.NewArrayListOf([]interface{}{ element })
+        clone   := source.Clone().(list.List)
+
+        element["lives"] = 2
+        if actual, _ := clone.ValueWithIndex(0); actual.(map[string]int)["lives"] != 2 {
+            t.Errorf("expected elements to be shared by the clone, but found '%v'", actual)
+        }
+    })
+}
+
+func TestStream(t *testing.T) {
+    elements := list.NewArrayList()
+    for i := 0; i < 4 * collection.StreamBufferSize; i++ {
+        _ = elements.Add(i)
+    }
+
+    t.Run("Drained", func(t *testing.T) {
+        count := 0
+        for element := range collection.Stream(elements, nil) {
+            if element != count {
+                t.Errorf("expected element %d, but found '%v'", count, element)
+            }
+            count++
+        }
+
+        if count != elements.Size() {
+            t.Errorf("expected %d elements, but found %d", elements.Size(), count)
+        }
+    })
+
+    t.Run("Done", func(t *testing.T) {
+        done   := make(chan struct{})
+        stream := collection.Stream(elements, done)
+
+        <-stream
+        close(done)
+
+        count := 1
+        for range stream {
+            count++
+        }
+
+        if count >= elements.Size() {
+            t.Errorf("expected the stream to stop early, but received all %d elements", count)
+        }
+    })
+}
@@ -0,0 +1,242 @@
+package generic
+
+import (
+    "fmt"
+    "reflect"
+    "strings"
+
+    "github.com/2speed/go-collection"
+    "github.com/pkg/errors"
+)
+
+// ArrayList is a simple implementation of a List whose elements are maintained by an internal slice of type T.
+// ArrayList does not make any guarantees for concurrent access.
+//
+// Example:
+//
+//   NewArrayListOf("one", "two", "three").
+//      Filter(func(element string) bool {        // filter out elements longer than three characters
+//          return len(element) <= 3
+//      }).
+//      Map(strings.ToUpper).                     // map each element to it's upper case variant
+//      ForEach(func(element string) {            // print each result
+//          fmt.Println(element)
+//      })
+//
+//   Result:
+//
+//     ONE
+//     TWO
+//
+type arrayList[T any] struct {
+    elements []T
+}
+
+// NewArrayList creates a new ArrayList for elements of type T.
+func NewArrayList[T any]() List[T] {
+    return &arrayList[T]{ elements: make([]T, 0) }
+}
+
+// NewArrayListOf creates a new ArrayList containing the provided elements.
+func NewArrayListOf[T any](elements ...T) List[T] {
+    l := &arrayList[T]{ elements: make([]T, len(elements)) }
+    copy(l.elements, elements)
+
+    return l
+}
+
+// Add inserts the provided element into the ArrayList.
+func (l *arrayList[T]) Add(element T) error {
+    l.elements = append(l.elements, element)
+
+    return nil
+}
+
+// AddAll inserts all elements from the provided List into the ArrayList.
+func (l *arrayList[T]) AddAll(other List[T]) error {
+    if other != nil {
+        l.elements = append(l.elements, other.Values()...)
+    }
+
+    return nil
+}
+
+// AddFirst inserts the provided element at the front (index == 0) of the ArrayList. The positions of the existing
+// elements are increased by one.
+func (l *arrayList[T]) AddFirst(element T) error {
+    l.elements = append([]T{ element }, l.elements...)
+
+    return nil
+}
+
+// AddLast inserts the provided element at the end of the ArrayList (index == ArrayList.Size()).
+func (l *arrayList[T]) AddLast(element T) error {
+    return l.Add(element)
+}
+
+// AddWithIndex inserts the provided element into the ArrayList specified by index. The position of the elements that
+// were at positions index to ArrayList.Size() - 1 increase by one. The returned error will be non-nil if the provided
+// index is outside the current bounds of the ArrayList (index < 0 || index > ArrayList.Size()).
+func (l *arrayList[T]) AddWithIndex(index int, element T) error {
+    if index < 0 || index > l.Size() {
+        return l.outOfBounds(index)
+    }
+
+    var zero T
+    l.elements = append(l.elements, zero)
+    copy(l.elements[index + 1:], l.elements[index:])
+    l.elements[index] = element
+
+    return nil
+}
+
+// ValueWithIndex returns the element at the position specified by the provided index. The returned error will be
+// non-nil if the provided index is outside the current bounds of the ArrayList
+// (index < 0 || index > ArrayList.Size() - 1).
+func (l *arrayList[T]) ValueWithIndex(index int) (T, error) {
+    if index < 0 || index >= l.Size() {
+        var zero T
+        return zero, l.outOfBounds(index)
+    }
+
+    return l.elements[index], nil
+}
+
+// IndexOf returns the position of the first occurrence (if any) of an element equivalent to the provided element. The
+// returned error will be non-nil if provided element is not found in the ArrayList, and the returned index will be
+// equal to collection.ElementNotFound.
+func (l *arrayList[T]) IndexOf(element T) (int, error) {
+    for i, v := range l.elements {
+        if reflect.DeepEqual(v, element) {
+            return i, nil
+        }
+    }
+
+    return collection.ElementNotFound, collection.ErrorElementNotFound
+}
+
+// Remove removes the first occurrence (if any) of an element equivalent to the provided element. If an element was
+// removed, the return value will be true, otherwise false will be returned.
+func (l *arrayList[T]) Remove(element T) bool {
+    if i, err := l.IndexOf(element); err == nil {
+        _, err = l.RemoveWithIndex(i)
+
+        return err == nil
+    }
+
+    return false
+}
+
+// RemoveFirst removes the element at the front (index == 0) of the ArrayList and returns it. The returned error will be
+// collection.ErrorEmpty if the ArrayList is empty (ArrayList.Size() == 0).
+func (l *arrayList[T]) RemoveFirst() (T, error) {
+    if l.IsEmpty() {
+        var zero T
+        return zero, collection.ErrorEmpty
+    }
+
+    return l.RemoveWithIndex(0)
+}
+
+// RemoveLast removes the element at the end (index == ArrayList.Size() - 1) of the ArrayList and returns it. The
+// returned error will be collection.ErrorEmpty if the ArrayList is empty (ArrayList.Size() == 0).
+func (l *arrayList[T]) RemoveLast() (T, error) {
+    if l.IsEmpty() {
+        var zero T
+        return zero, collection.ErrorEmpty
+    }
+
+    return l.RemoveWithIndex(l.Size() - 1)
+}
+
+// RemoveWithIndex removes the element at the provided index from the ArrayList and returns it. The positions of the
+// elements originally at positions index + 1 to ArrayList.Size() - 1 are decremented by 1. The returned error will be
+// non-nil if the provided index is outside the bounds of the ArrayList (index < 0 || index > ArrayList.Size() - 1).
+func (l *arrayList[T]) RemoveWithIndex(index int) (T, error) {
+    element, err := l.ValueWithIndex(index)
+    if err != nil {
+        return element, err
+    }
+
+    var zero T
+    copy(l.elements[index:], l.elements[index + 1:])
+    l.elements[l.Size() - 1] = zero
+    l.elements = l.elements[:l.Size() - 1]
+
+    return element, nil
+}
+
+// Filter returns a new ArrayList consisting of the elements of this ArrayList that match the given predicate.
+func (l *arrayList[T]) Filter(predicate func(element T) bool) List[T] {
+    list := NewArrayList[T]()
+
+    l.ForEach(func(element T) {
+        if predicate(element) {
+            _ = list.Add(element)
+        }
+    })
+
+    return list
+}
+
+// Map returns a new ArrayList containing the resulting elements of applying the given function to the elements of this
+// ArrayList.
+func (l *arrayList[T]) Map(mapper func(element T) T) List[T] {
+    return Map[T, T](l, mapper)
+}
+
+// ForEach performs the provided consumer function for each element of the ArrayList.
+func (l *arrayList[T]) ForEach(consumer func(element T)) {
+    for _, v := range l.elements {
+        consumer(v)
+    }
+}
+
+// Size returns the number of elements in the ArrayList.
+func (l *arrayList[T]) Size() int {
+    return len(l.elements)
+}
+
+// IsEmpty returns true if the ArrayList contains no elements, otherwise false is returned.
+func (l *arrayList[T]) IsEmpty() bool {
+    return l.Size() == 0
+}
+
+// Clear removes all elements from the ArrayList.
+func (l *arrayList[T]) Clear() {
+    l.elements = make([]T, 0)
+}
+
+// Contains returns true if an element equivalent to the provided element exists in the ArrayList, otherwise false is
+// returned.
+func (l *arrayList[T]) Contains(element T) bool {
+    _, err := l.IndexOf(element)
+
+    return err == nil
+}
+
+// Values returns a slice containing the elements in the ArrayList in the iteration order.
+func (l *arrayList[T]) Values() []T {
+    elements := make([]T, l.Size())
+    copy(elements, l.elements)
+
+    return elements
+}
+
+// String returns a string representation of the ArrayList in it's current state.
+func (l *arrayList[T]) String() string {
+    if l.Size() == 0 {
+        return "[]"
+    }
+
+    elements := make([]string, 0, l.Size())
+    l.ForEach(func(element T) {
+        elements = append(elements, fmt.Sprintf("%v", element))
+    })
+
+    return "[" + strings.Join(elements, ", ") + "]"
+}
+
+func (l *arrayList[T]) outOfBounds(index int) error {
+    return errors.Errorf("index out of bounds [*ArrayList.Size() = %v, requested index = %v]", l.Size(), index)
+}
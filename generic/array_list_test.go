@@ -0,0 +1,181 @@
+package generic
+
+import (
+    "reflect"
+    "strconv"
+    "testing"
+
+    "github.com/2speed/go-collection"
+)
+
+func TestArrayList_Add(t *testing.T) {
+    t.Run("Add", func(t *testing.T) {
+        list := NewArrayListOf(1, 2, 3)
+        err  := list.Add(4)
+
+        assertError(t, err, nil)
+        assertContentEquals(t, list, []int{ 1, 2, 3, 4 })
+    })
+
+    t.Run("AddAll", func(t *testing.T) {
+        list := NewArrayListOf(1, 2)
+        err  := list.AddAll(NewArrayListOf(3, 4))
+
+        assertError(t, err, nil)
+        assertContentEquals(t, list, []int{ 1, 2, 3, 4 })
+    })
+
+    t.Run("AddFirst", func(t *testing.T) {
+        list := NewArrayListOf(1, 2, 3)
+        err  := list.AddFirst(0)
+
+        assertError(t, err, nil)
+        assertContentEquals(t, list, []int{ 0, 1, 2, 3 })
+    })
+
+    t.Run("AddWithIndex", func(t *testing.T) {
+        list := NewArrayListOf(1, 3)
+
+        assertError(t, list.AddWithIndex(1, 2), nil)
+        assertError(t, list.AddWithIndex(3, 4), nil)
+        assertContentEquals(t, list, []int{ 1, 2, 3, 4 })
+
+        if err := list.AddWithIndex(5, 5); err == nil {
+            t.Errorf("expected error for index out of bounds")
+        }
+    })
+}
+
+func TestArrayList_Remove(t *testing.T) {
+    t.Run("Remove", func(t *testing.T) {
+        list := NewArrayListOf(1, 2, 3, 2)
+
+        if !list.Remove(2) {
+            t.Errorf("expected element 2 to be removed")
+        }
+        if list.Remove(5) {
+            t.Errorf("expected element 5 not to be removed")
+        }
+        assertContentEquals(t, list, []int{ 1, 3, 2 })
+    })
+
+    t.Run("RemoveFirstLast", func(t *testing.T) {
+        list := NewArrayListOf(1, 2, 3)
+
+        first, err := list.RemoveFirst()
+        assertError(t, err, nil)
+        assertValue(t, first, 1)
+
+        last, err := list.RemoveLast()
+        assertError(t, err, nil)
+        assertValue(t, last, 3)
+        assertContentEquals(t, list, []int{ 2 })
+    })
+
+    t.Run("RemoveFirstEmpty", func(t *testing.T) {
+        list := NewArrayList[int]()
+
+        _, err := list.RemoveFirst()
+        assertError(t, err, collection.ErrorEmpty)
+
+        _, err = list.RemoveLast()
+        assertError(t, err, collection.ErrorEmpty)
+    })
+
+    t.Run("RemoveWithIndex", func(t *testing.T) {
+        list := NewArrayListOf(1, 2, 3)
+
+        value, err := list.RemoveWithIndex(1)
+        assertError(t, err, nil)
+        assertValue(t, value, 2)
+        assertContentEquals(t, list, []int{ 1, 3 })
+
+        if _, err := list.RemoveWithIndex(2); err == nil {
+            t.Errorf("expected error for index out of bounds")
+        }
+    })
+}
+
+func TestArrayList_Accessors(t *testing.T) {
+    list := NewArrayListOf(5, 10, 15)
+
+    value, err := list.ValueWithIndex(1)
+    assertError(t, err, nil)
+    assertValue(t, value, 10)
+
+    index, err := list.IndexOf(15)
+    assertError(t, err, nil)
+    assertValue(t, index, 2)
+
+    index, err = list.IndexOf(20)
+    assertError(t, err, collection.ErrorElementNotFound)
+    assertValue(t, index, collection.ElementNotFound)
+
+    if !list.Contains(5) || list.Contains(20) {
+        t.Errorf("expected list %v to contain only 5, 10 and 15", list)
+    }
+
+    list.Clear()
+    if !list.IsEmpty() {
+        t.Errorf("expected list to be empty after Clear, got %v", list)
+    }
+}
+
+func TestArrayList_Functional(t *testing.T) {
+    list := NewArrayListOf(1, 2, 3, 4, 5, 6)
+
+    t.Run("Filter", func(t *testing.T) {
+        evens := list.Filter(func(element int) bool { return element % 2 == 0 })
+
+        assertContentEquals(t, evens, []int{ 2, 4, 6 })
+    })
+
+    t.Run("Map", func(t *testing.T) {
+        squares := list.Map(func(element int) int { return element * element })
+
+        assertContentEquals(t, squares, []int{ 1, 4, 9, 16, 25, 36 })
+    })
+
+    t.Run("MapToType", func(t *testing.T) {
+        strings := Map(list, strconv.Itoa)
+
+        assertContentEquals(t, strings, []string{ "1", "2", "3", "4", "5", "6" })
+    })
+
+    t.Run("Reduce", func(t *testing.T) {
+        sum  := Reduce(list, 0, func(result int, element int) int { return result + element })
+        text := Reduce(list, "", func(result string, element int) string { return result + strconv.Itoa(element) })
+
+        assertValue(t, sum, 21)
+        assertValue(t, text, "123456")
+    })
+
+    t.Run("String", func(t *testing.T) {
+        assertValue(t, list.String(), "[1, 2, 3, 4, 5, 6]")
+        assertValue(t, NewArrayList[int]().String(), "[]")
+    })
+}
+
+func assertError(t *testing.T, actual error, expected error) {
+    t.Helper()
+
+    if actual != expected {
+        t.Errorf("expected error: %v, actual error: %v", expected, actual)
+    }
+}
+
+func assertValue[T any](t *testing.T, actual T, expected T) {
+    t.Helper()
+
+    if !reflect.DeepEqual(actual, expected) {
+        t.Errorf("expected value: %v, actual value: %v", expected, actual)
+    }
+}
+
+func assertContentEquals[T any](t *testing.T, list List[T], expected []T) {
+    t.Helper()
+
+    if !reflect.DeepEqual(list.Values(), expected) {
+        t.Errorf("expected content: %v, actual content: %v", expected, list.Values())
+    }
+}
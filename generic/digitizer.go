@@ -0,0 +1,170 @@
+package generic
+
+import (
+    "github.com/2speed/go-collection/trie"
+    "github.com/pkg/errors"
+)
+
+// Digitizer defines the behavior for converting elements of type K into the sequence of digits used to place them in
+// a Trie. Digitizer mirrors the behavior of trie.Digitizer, but since the elements are typed, no type assertions are
+// required when digitizing an element.
+type Digitizer[K any] interface {
+
+    // Base returns the base for the Digitizer.
+    Base() int
+
+    // IsPrefixFree returns true if and only if the Digitizer guarantees that no element is a prefix of another.
+    IsPrefixFree() bool
+
+    // NumDigitsOf returns the number of digits in the provided element.
+    NumDigitsOf(element K) int
+
+    // DigitOf returns the element of digit place for the provided element.
+    DigitOf(element K, place int) int
+
+    // FormatDigit returns a string representation of the digit in the place specified for the given element.
+    FormatDigit(element K, place int) string
+
+    // Accepts returns a non-nil error if the provided element cannot be digitized, such as an element containing
+    // characters outside of the alphabet of the Digitizer.
+    Accepts(element K) error
+
+    // Alphabet returns the characters accepted by the Digitizer in the order of their digits, excluding the end of key
+    // character (if any).
+    Alphabet() []rune
+
+    // IsValidDigit returns true if the provided digit is mapped to by a character of the alphabet of the Digitizer,
+    // otherwise false is returned.
+    IsValidDigit(digit int) bool
+}
+
+type stringDigitizer struct {
+    base int
+}
+
+// NewStringDigitizer creates a new Digitizer for strings with the provided alphabet size. Upper and lower case letters
+// are mapped to the same digit.
+func NewStringDigitizer(alphabetSize int) Digitizer[string] {
+    return &stringDigitizer{ base: alphabetSize + 1 }
+}
+
+// Base the base of the alphabet that includes the end of string character.
+func (d *stringDigitizer) Base() int {
+    return d.base
+}
+
+// IsPrefixFree returns true since this is a prefix free digitizer.
+func (d *stringDigitizer) IsPrefixFree() bool {
+    return true
+}
+
+// NumDigitsOf returns the number of digits in the provided string including the end of string character.
+func (d *stringDigitizer) NumDigitsOf(element string) int {
+    return len(element) + 1
+}
+
+// DigitOf returns the integer element mapped to by the digit in the given place.
+func (d *stringDigitizer) DigitOf(element string, place int) int {
+    if place >= len(element) {
+        return 0
+    }
+
+    return int(lower(element[place]) - 'a' + 1)
+}
+
+// FormatDigit returns a string representation of the digit in the place specified for the given element where '#' is
+// used for the end of string character.
+func (d *stringDigitizer) FormatDigit(element string, place int) string {
+    if place >= len(element) {
+        return "#"
+    }
+
+    return string(lower(element[place]))
+}
+
+// Accepts returns a non-nil error if the provided string contains characters outside of the alphabet of the
+// StringDigitizer.
+func (d *stringDigitizer) Accepts(element string) error {
+    for place := 0; place < len(element); place++ {
+        if digit := d.DigitOf(element, place); digit < 1 || digit >= d.base {
+            return errors.Errorf("element contains a character outside of the digitizer alphabet at place %v: %v", place, element)
+        }
+    }
+
+    return nil
+}
+
+// Alphabet returns the letters of the StringDigitizer in order.
+func (d *stringDigitizer) Alphabet() []rune {
+    alphabet := make([]rune, 0, d.base - 1)
+    for digit := 1; digit < d.base; digit++ {
+        alphabet = append(alphabet, rune('a' + digit - 1))
+    }
+
+    return alphabet
+}
+
+// IsValidDigit returns true if the provided digit is within 1 and the base of the StringDigitizer (exclusive).
+func (d *stringDigitizer) IsValidDigit(digit int) bool {
+    return digit >= 1 && digit < d.base
+}
+
+func lower(c byte) byte {
+    if c >= 'A' && c <= 'Z' {
+        return c - 'A' + 'a'
+    }
+
+    return c
+}
+
+// digitizerAdapter adapts a typed Digitizer to a trie.Digitizer, so that the elements of a generic Trie can be placed by
+// the trie package. Since the generic Trie only passes elements of type K to the trie package, the type assertions of
+// the adapter are guaranteed to succeed for every element other than those rejected by Accepts.
+type digitizerAdapter[K any] struct {
+    digitizer Digitizer[K]
+}
+
+func newDigitizerAdapter[K any](digitizer Digitizer[K]) trie.Digitizer {
+    return &digitizerAdapter[K]{ digitizer: digitizer }
+}
+
+func (d *digitizerAdapter[K]) Base() int {
+    return d.digitizer.Base()
+}
+
+func (d *digitizerAdapter[K]) IsPrefixFree() bool {
+    return d.digitizer.IsPrefixFree()
+}
+
+func (d *digitizerAdapter[K]) NumDigitsOf(element interface{}) int {
+    return d.digitizer.NumDigitsOf(element.(K))
+}
+
+func (d *digitizerAdapter[K]) DigitOf(element interface{}, place int) int {
+    return d.digitizer.DigitOf(element.(K), place)
+}
+
+func (d *digitizerAdapter[K]) FormatDigit(element interface{}, place int) string {
+    return d.digitizer.FormatDigit(element.(K), place)
+}
+
+func (d *digitizerAdapter[K]) Compare(a interface{}, b interface{}) int {
+    return trie.CompareDigits(d, a, b)
+}
+
+func (d *digitizerAdapter[K]) Accepts(element interface{}) error {
+    key, ok := element.(K)
+    if !ok {
+        return errors.Errorf("element of type %T is not supported by the digitizer: %v", element, element)
+    }
+
+    return d.digitizer.Accepts(key)
+}
+
+func (d *digitizerAdapter[K]) Alphabet() []rune {
+    return d.digitizer.Alphabet()
+}
+
+func (d *digitizerAdapter[K]) IsValidDigit(digit int) bool {
+    return d.digitizer.IsValidDigit(digit)
+}
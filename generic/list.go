@@ -0,0 +1,99 @@
+package generic
+
+// List defines the behavior for a container the represents a collection of elements of type T that are accessed via
+// their position much like that of an array or slice. List mirrors the behavior of list.List, but since the elements
+// are typed, no type assertions are required for elements returned from accessor methods.
+type List[T any] interface {
+    // Add inserts the provided element into the List.
+    Add(element T) error
+
+    // AddAll inserts all elements from the provided List into the List.
+    AddAll(other List[T]) error
+
+    // AddFirst inserts the provided element at the front (index == 0) of the List. The positions of the existing
+    // elements are increased by one.
+    AddFirst(element T) error
+
+    // AddLast inserts the provided element at the end of the List (index == List.Size()).
+    AddLast(element T) error
+
+    // AddWithIndex inserts the provided element into the List specified by index. The position of the elements that
+    // were at positions index to List.Size() - 1 increase by one. The returned error will be non-nil if the provided
+    // index is outside the current bounds of the List (index < 0 || index > List.Size()).
+    AddWithIndex(index int, element T) error
+
+    // ValueWithIndex returns the element at the position specified by the provided index. The returned error will be
+    // non-nil if the provided index is outside the current bounds of the List (index < 0 || index > List.Size() - 1).
+    ValueWithIndex(index int) (T, error)
+
+    // IndexOf returns the position of the first occurrence (if any) of an element equivalent to the provided element.
+    // The returned error will be non-nil if provided element is not found in the List, and the returned index will be
+    // equal to collection.ElementNotFound.
+    IndexOf(element T) (int, error)
+
+    // Remove removes the first occurrence (if any) of an element equivalent to the provided element. If an element was
+    // removed, the return value will be true, otherwise false will be returned.
+    Remove(element T) bool
+
+    // RemoveFirst removes the element at the front (index == 0) of the List and returns it. The returned error will be
+    // collection.ErrorEmpty if the List is empty (List.Size() == 0).
+    RemoveFirst() (T, error)
+
+    // RemoveLast removes the element at the end (index == List.Size() - 1) of the List and returns it. The returned
+    // error will be collection.ErrorEmpty if the List is empty (List.Size() == 0).
+    RemoveLast() (T, error)
+
+    // RemoveWithIndex removes the element at the provided index from the List and returns it. The positions of the
+    // elements originally at positions index + 1 to List.Size() - 1 are decremented by 1. The returned error will be
+    // non-nil if the provided index is outside the bounds of the List (index < 0 || index > List.Size() - 1).
+    RemoveWithIndex(index int) (T, error)
+
+    // Filter returns a new List consisting of the elements of this List that match the given predicate.
+    Filter(predicate func(element T) bool) List[T]
+
+    // Map returns a new List containing the resulting elements of applying the given function to the elements of this
+    // List. Use the Map function to map the elements to a different type.
+    Map(mapper func(element T) T) List[T]
+
+    // ForEach performs the provided consumer function for each element of the List.
+    ForEach(consumer func(element T))
+
+    // Size returns the number of elements in the List.
+    Size() int
+
+    // IsEmpty returns true if the List contains no elements, otherwise false is returned.
+    IsEmpty() bool
+
+    // Clear removes all elements from the List.
+    Clear()
+
+    // Contains returns true if an element equivalent to the provided element exists in the List, otherwise false is
+    // returned.
+    Contains(element T) bool
+
+    // Values returns a slice containing the elements in the List in the iteration order.
+    Values() []T
+
+    // String returns a string representation of the List in it's current state.
+    String() string
+}
+
+// Map returns a new List containing the resulting elements of applying the given function to the elements of the
+// provided List. Unlike List.Map, the resulting elements may be of a different type than the elements of the List.
+func Map[T, R any](l List[T], mapper func(element T) R) List[R] {
+    list := NewArrayList[R]()
+
+    l.ForEach(func(element T) { _ = list.Add(mapper(element)) })
+
+    return list
+}
+
+// Reduce combines the elements of the provided List in the iteration order by applying the given accumulator function
+// to the running result and each element, starting from the provided identity, and returns the final result.
+func Reduce[T, R any](l List[T], identity R, accumulator func(result R, element T) R) R {
+    result := identity
+
+    l.ForEach(func(element T) { result = accumulator(result, element) })
+
+    return result
+}
@@ -0,0 +1,164 @@
+package generic
+
+import (
+    "fmt"
+
+    "github.com/2speed/go-collection"
+    "github.com/2speed/go-collection/list"
+    "github.com/2speed/go-collection/trie"
+)
+
+// Trie defines the behavior for an ordered container of elements of type K that are placed by the digits provided by a
+// Digitizer. Trie mirrors the behavior of trie.Trie, but since the elements are typed, elements of any other type are
+// rejected at compile time rather than by the Digitizer.
+type Trie[K any] interface {
+    // Add inserts the provided element into the Trie. The returned error will be non-nil if the element is not accepted
+    // by the Digitizer of the Trie.
+    Add(element K) error
+
+    // Remove removes the provided element from the Trie. If the element was removed, the return value will be true,
+    // otherwise false will be returned.
+    Remove(element K) bool
+
+    // Contains returns true if the provided element exists in the Trie, otherwise false is returned.
+    Contains(element K) bool
+
+    // Completions returns a List of the elements in the Trie that match the provided prefix in the iteration order.
+    Completions(prefix K) List[K]
+
+    // CountCompletions returns the number of elements in the Trie that match the provided prefix.
+    CountCompletions(prefix K) int
+
+    // HasPrefix returns true if at least one element in the Trie matches the provided prefix, otherwise false is
+    // returned.
+    HasPrefix(prefix K) bool
+
+    // Min returns the first element in the iteration order of the Trie. The returned error will be collection.ErrorEmpty
+    // if the Trie is empty.
+    Min() (K, error)
+
+    // Max returns the last element in the iteration order of the Trie. The returned error will be collection.ErrorEmpty
+    // if the Trie is empty.
+    Max() (K, error)
+
+    // Size returns the number of elements in the Trie.
+    Size() int
+
+    // IsEmpty returns true if the Trie contains no elements, otherwise false is returned.
+    IsEmpty() bool
+
+    // Clear removes all elements from the Trie.
+    Clear()
+
+    // Values returns a slice containing the elements in the Trie in the iteration order.
+    Values() []K
+
+    // String returns a string representation of the Trie in it's current state.
+    String() string
+}
+
+type typedTrie[K any] struct {
+    trie trie.Trie
+}
+
+// NewTrie creates a new Trie that uses the provided Digitizer.
+func NewTrie[K any](digitizer Digitizer[K]) Trie[K] {
+    return &typedTrie[K]{ trie: trie.NewTrieWithDigitizer(newDigitizerAdapter(digitizer)) }
+}
+
+// NewRadixTree creates a new Trie backed by a radix tree that uses the provided Digitizer.
+func NewRadixTree[K any](digitizer Digitizer[K]) Trie[K] {
+    return &typedTrie[K]{ trie: trie.NewRadixTreeWithDigitizer(newDigitizerAdapter(digitizer)) }
+}
+
+// Add inserts the provided element into the Trie. The returned error will be non-nil if the element is not accepted by
+// the Digitizer of the Trie.
+func (t *typedTrie[K]) Add(element K) error {
+    return t.trie.Add(element)
+}
+
+// Remove removes the provided element from the Trie. If the element was removed, the return value will be true,
+// otherwise false will be returned.
+func (t *typedTrie[K]) Remove(element K) bool {
+    return t.trie.Remove(element)
+}
+
+// Contains returns true if the provided element exists in the Trie, otherwise false is returned.
+func (t *typedTrie[K]) Contains(element K) bool {
+    return t.trie.Contains(element)
+}
+
+// Completions returns a List of the elements in the Trie that match the provided prefix in the iteration order.
+func (t *typedTrie[K]) Completions(prefix K) List[K] {
+    completions := list.NewArrayList()
+    t.trie.Completions(prefix, completions)
+
+    return NewArrayListOf(keysOf[K](completions.Values())...)
+}
+
+// CountCompletions returns the number of elements in the Trie that match the provided prefix.
+func (t *typedTrie[K]) CountCompletions(prefix K) int {
+    return t.trie.CountCompletions(prefix)
+}
+
+// HasPrefix returns true if at least one element in the Trie matches the provided prefix, otherwise false is returned.
+func (t *typedTrie[K]) HasPrefix(prefix K) bool {
+    return t.trie.HasPrefix(prefix)
+}
+
+// Min returns the first element in the iteration order of the Trie. The returned error will be collection.ErrorEmpty if
+// the Trie is empty.
+func (t *typedTrie[K]) Min() (K, error) {
+    return t.keyOf(t.trie.Min())
+}
+
+// Max returns the last element in the iteration order of the Trie. The returned error will be collection.ErrorEmpty if
+// the Trie is empty.
+func (t *typedTrie[K]) Max() (K, error) {
+    return t.keyOf(t.trie.Max())
+}
+
+// Size returns the number of elements in the Trie.
+func (t *typedTrie[K]) Size() int {
+    return t.trie.Size()
+}
+
+// IsEmpty returns true if the Trie contains no elements, otherwise false is returned.
+func (t *typedTrie[K]) IsEmpty() bool {
+    return t.trie.IsEmpty()
+}
+
+// Clear removes all elements from the Trie.
+func (t *typedTrie[K]) Clear() {
+    t.trie.Clear()
+}
+
+// Values returns a slice containing the elements in the Trie in the iteration order.
+func (t *typedTrie[K]) Values() []K {
+    return keysOf[K](t.trie.Values())
+}
+
+// String returns a string representation of the Trie in it's current state.
+func (t *typedTrie[K]) String() string {
+    return fmt.Sprintf("%v", t.trie)
+}
+
+func (t *typedTrie[K]) keyOf(element interface{}) (K, error) {
+    if t.trie.IsEmpty() {
+        var zero K
+        return zero, collection.ErrorEmpty
+    }
+
+    return element.(K), nil
+}
+
+// keysOf converts the provided elements, which are known to be of type K since only elements of type K are added to
+// the underlying trie, to a slice of type K.
+func keysOf[K any](elements []interface{}) []K {
+    keys := make([]K, len(elements))
+    for i, element := range elements {
+        keys[i] = element.(K)
+    }
+
+    return keys
+}
@@ -0,0 +1,72 @@
+package generic
+
+import (
+    "testing"
+
+    "github.com/2speed/go-collection"
+)
+
+// Add only accepts elements of type K, so adding an element of any other type is rejected at compile time. For
+// instance, NewTrie(NewStringDigitizer(26)).Add(42) fails to compile with "cannot use 42 (untyped int constant) as
+// string value in argument".
+var _ func(string) error = NewTrie(NewStringDigitizer(26)).Add
+
+func TestTrie(t *testing.T) {
+    elements := []string{ "cargo", "crate", "rustc", "rustup", "clippy" }
+
+    for name, trieOf := range map[string]func(Digitizer[string]) Trie[string]{ "Trie": NewTrie[string], "RadixTree": NewRadixTree[string] } {
+        t.Run(name, func(t *testing.T) {
+            trie := trieOf(NewStringDigitizer(26))
+            for _, element := range elements {
+                assertError(t, trie.Add(element), nil)
+            }
+
+            assertValue(t, trie.Size(), 5)
+            assertValue(t, trie.Values(), []string{ "cargo", "clippy", "crate", "rustc", "rustup" })
+            assertValue(t, trie.Contains("rustc"), true)
+            assertValue(t, trie.Contains("rust"), false)
+
+            assertContentEquals(t, trie.Completions("rust"), []string{ "rustc", "rustup" })
+            assertContentEquals(t, trie.Completions("go"), []string{})
+            assertValue(t, trie.CountCompletions("c"), 3)
+            assertValue(t, trie.HasPrefix("cr"), true)
+
+            min, err := trie.Min()
+            assertError(t, err, nil)
+            assertValue(t, min, "cargo")
+
+            max, err := trie.Max()
+            assertError(t, err, nil)
+            assertValue(t, max, "rustup")
+
+            assertValue(t, trie.Remove("crate"), true)
+            assertValue(t, trie.Remove("crate"), false)
+            assertValue(t, trie.Size(), 4)
+
+            if err := trie.Add("rust-lang"); err == nil {
+                t.Errorf("expected error for element outside of the digitizer alphabet")
+            }
+
+            trie.Clear()
+            assertValue(t, trie.IsEmpty(), true)
+
+            _, err = trie.Min()
+            assertError(t, err, collection.ErrorEmpty)
+        })
+    }
+}
+
+func TestStringDigitizer(t *testing.T) {
+    digitizer := NewStringDigitizer(26)
+
+    assertValue(t, digitizer.NumDigitsOf("Go"), 3)
+    assertValue(t, digitizer.DigitOf("Go", 0), 7)
+    assertValue(t, digitizer.DigitOf("Go", 2), 0)
+    assertValue(t, digitizer.FormatDigit("Go", 0), "g")
+    assertValue(t, digitizer.FormatDigit("Go", 2), "#")
+    assertError(t, digitizer.Accepts("gopher"), nil)
+
+    if err := digitizer.Accepts("go1"); err == nil {
+        t.Errorf("expected error for element outside of the digitizer alphabet")
+    }
+}
@@ -1,5 +1,5 @@
 module github.com/2speed/go-collection
 
-go 1.13
+go 1.18
 
 require github.com/pkg/errors v0.9.1
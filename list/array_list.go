@@ -1,9 +1,11 @@
 package list
 
 import (
-    "fmt"
+    "bytes"
+    "encoding/gob"
+    "encoding/json"
     "reflect"
-    "strings"
+    "sort"
 
     "github.com/2speed/go-collection"
     "github.com/pkg/errors"
@@ -43,6 +45,11 @@ func NewArrayList() List {
     return &arrayList{ elements: make([]interface{}, 0) }
 }
 
+// newArrayListWithCapacity creates a new ArrayList whose backing slice has the provided capacity.
+func newArrayListWithCapacity(capacity int) *arrayList {
+    return &arrayList{ elements: make([]interface{}, 0, capacity) }
+}
+
 // NewArrayListOf creates a new ArrayList containing the provided elements.
 func NewArrayListOf(elements interface{}) List {
     l := NewArrayList()
@@ -82,7 +89,8 @@ func (l *arrayList) Add(element interface{}) error {
     return nil
 }
 
-// AddAll inserts all elements from the provided List into the ArrayList.
+// AddAll inserts all elements from the provided Collection into the ArrayList. The elements of the provided Collection
+// are copied before they are appended, so an ArrayList may be added to itself.
 func (l *arrayList) AddAll(collection collection.Collection) error {
     if collection != nil {
         l.elements = append(l.elements, collection.Values()...)
@@ -104,11 +112,18 @@ func (l *arrayList) AddLast(element interface{}) error {
     return l.Add(element)
 }
 
+// AddValues inserts the provided elements at the end of the ArrayList in the order they are provided.
+func (l *arrayList) AddValues(elements ...interface{}) error {
+    l.elements = append(l.elements, elements...)
+
+    return nil
+}
+
 // AddWithIndex inserts the provided element into the ArrayList specified by index. The position of the elements that
 // were at positions index to ArrayList.Size() - 1 increase by one. The returned error will be non-nil if the provided
 // index is outside the current bounds of the ArrayList (index < 0 || index > ArrayList.Size() - 1).
 func (l *arrayList) AddWithIndex(index int, element interface{}) error {
-    if err := l.checkBounds(index); err != nil {
+    if err := l.checkInsertBounds(index); err != nil {
         return err
     }
 
@@ -157,6 +172,18 @@ func (l *arrayList) Remove(element interface{}) bool {
     return false
 }
 
+// RemoveAll removes every occurrence of each element of the provided collection from the ArrayList, and returns the
+// number of elements removed. A nil collection removes no elements.
+func (l *arrayList) RemoveAll(other collection.Collection) int {
+    return collection.RemoveAll(l, other)
+}
+
+// RetainAll removes every element from the ArrayList that does not exist in the provided collection, and returns the
+// number of elements removed. A nil collection is treated as an empty collection.
+func (l *arrayList) RetainAll(other collection.Collection) int {
+    return collection.RetainAll(l, other)
+}
+
 // RemoveFirst removes the element at the front (index == 0) of the ArrayList and returns it. If the ArrayList is empty
 // (ArrayList.Size() == 0), the return value will be nil.
 func (l *arrayList) RemoveFirst() interface{} {
@@ -183,7 +210,9 @@ func (l *arrayList) RemoveLast() interface{} {
 
 // RemoveWithIndex removes the element at the provided index from the ArrayList and returns it. The positions of the
 // elements originally at positions index + 1 to ArrayList.Size() - 1 are decremented by 1. The returned error will be
-// non-nil if the provided index is outside the bounds of the ArrayList (index < 0 || index > ArrayList.Size() - 1).
+// non-nil if the provided index is outside the bounds of the ArrayList (index < 0 || index > ArrayList.Size() - 1). The
+// vacated slot at the end of the backing slice is cleared, so the ArrayList does not retain a reference to the removed
+// element.
 func (l *arrayList) RemoveWithIndex(index int) (interface{}, error) {
     element, err := l.ValueWithIndex(index)
     if err != nil {
@@ -191,34 +220,195 @@ func (l *arrayList) RemoveWithIndex(index int) (interface{}, error) {
     }
 
     copy(l.elements[index:l.Size() - 1], l.elements[index + 1:l.Size()])
+    l.elements[l.Size() - 1] = nil
     l.elements = l.elements[:l.Size() - 1]
 
     return element, nil
 }
 
-// Filter returns a new ArrayList consisting of the elements of this ArrayList that match the given predicate.
+// RemoveFirstMatch removes the first element of the ArrayList that matches the given predicate and returns it. The
+// elements are scanned until the match is found, and the elements after it are then shifted once to close the gap.
+func (l *arrayList) RemoveFirstMatch(predicate func(element interface{}) bool) (interface{}, bool) {
+    for i, element := range l.elements {
+        if predicate(element) {
+            _, _ = l.RemoveWithIndex(i)
+
+            return element, true
+        }
+    }
+
+    return nil, false
+}
+
+// RemoveRange removes the elements of the ArrayList at positions from (inclusive) to to (exclusive) by shifting the
+// elements that follow them once. An empty range (from == to) removes no elements. The returned error will be non-nil
+// if the provided range is outside the current bounds of the ArrayList (from < 0 || from > to || to > ArrayList.Size()).
+func (l *arrayList) RemoveRange(from int, to int) error {
+    if err := l.checkRange(from, to); err != nil {
+        return err
+    }
+
+    n := copy(l.elements[from:], l.elements[to:])
+    for i := from + n; i < l.Size(); i++ {
+        l.elements[i] = nil
+    }
+    l.elements = l.elements[:from + n]
+
+    return nil
+}
+
+// Splice removes the elements of the ArrayList at positions from (inclusive) to to (exclusive), and returns them as a
+// new ArrayList. The returned error will be non-nil if the provided range is outside the current bounds of the
+// ArrayList (from < 0 || from > to || to > ArrayList.Size()).
+func (l *arrayList) Splice(from int, to int) (List, error) {
+    if err := l.checkRange(from, to); err != nil {
+        return nil, err
+    }
+
+    list := newArrayListWithCapacity(to - from)
+    list.elements = append(list.elements, l.elements[from:to]...)
+    _ = l.RemoveRange(from, to)
+
+    return list, nil
+}
+
+// Compact removes every nil element from the ArrayList, and returns the number of elements removed. The remaining
+// elements are shifted towards the front of the backing slice in a single pass.
+func (l *arrayList) Compact() int {
+    compacted := l.elements[:0]
+    for _, v := range l.elements {
+        if v != nil {
+            compacted = append(compacted, v)
+        }
+    }
+
+    removed := len(l.elements) - len(compacted)
+    for i := len(compacted); i < len(l.elements); i++ {
+        l.elements[i] = nil
+    }
+    l.elements = compacted
+
+    return removed
+}
+
+// InsertSorted inserts the provided element into the ArrayList after any elements that are not greater than it
+// according to the provided comparator, and returns the position where the element was placed. The insertion position
+// is found with a binary search, so the ArrayList is assumed to already be sorted by the comparator.
+func (l *arrayList) InsertSorted(element interface{}, less func(a, b interface{}) bool) int {
+    index := l.upperBound(element, less)
+    _ = l.AddWithIndex(index, element)
+
+    return index
+}
+
+// Fill replaces every element of the ArrayList with the provided value. The size of the ArrayList is unchanged.
+func (l *arrayList) Fill(value interface{}) {
+    _ = l.FillRange(0, l.Size(), value)
+}
+
+// FillRange replaces the elements of the ArrayList at positions from (inclusive) to to (exclusive) with the provided
+// value. The size of the ArrayList is unchanged. The returned error will be non-nil if the provided range is outside
+// the current bounds of the ArrayList (from < 0 || from > to || to > ArrayList.Size()).
+func (l *arrayList) FillRange(from int, to int, value interface{}) error {
+    if err := l.checkRange(from, to); err != nil {
+        return err
+    }
+
+    for i := from; i < to; i++ {
+        l.elements[i] = value
+    }
+
+    return nil
+}
+
+// Apply replaces each element of the ArrayList with the result of applying the provided function to it. Unlike Map, the
+// elements are overwritten in the backing slice of the ArrayList, so no new backing slice is allocated.
+func (l *arrayList) Apply(mapper func(element interface{}) interface{}) {
+    for i, element := range l.elements {
+        l.elements[i] = mapper(element)
+    }
+}
+
+// Filter returns a new ArrayList consisting of the elements of this ArrayList that match the given predicate. The
+// backing slice of the new ArrayList is allocated up front with the size of this ArrayList as its capacity.
 func (l *arrayList) Filter(predicate func(element interface{}) bool) List {
-    list := NewArrayList()
+    list := newArrayListWithCapacity(l.Size())
 
     l.ForEach(func(element interface{}) {
         if predicate(element) {
-            _ = list.Add(element)
+            list.elements = append(list.elements, element)
         }
     })
 
     return list
 }
 
+// FilterIndexed returns a new ArrayList consisting of the elements of this ArrayList that match the given predicate,
+// which is provided the position of each element in this ArrayList along with the element.
+func (l *arrayList) FilterIndexed(predicate func(index int, element interface{}) bool) List {
+    list := newArrayListWithCapacity(l.Size())
+
+    for i, element := range l.elements {
+        if predicate(i, element) {
+            list.elements = append(list.elements, element)
+        }
+    }
+
+    return list
+}
+
+// Find returns the first element of the ArrayList that matches the given predicate, and true if such an element exists.
+func (l *arrayList) Find(predicate func(element interface{}) bool) (interface{}, bool) {
+    for _, element := range l.elements {
+        if predicate(element) {
+            return element, true
+        }
+    }
+
+    return nil, false
+}
+
+// Any returns true if at least one element of the ArrayList matches the given predicate, otherwise false is returned.
+func (l *arrayList) Any(predicate func(element interface{}) bool) bool {
+    _, found := l.Find(predicate)
+
+    return found
+}
+
+// All returns true if every element of the ArrayList matches the given predicate, otherwise false is returned.
+func (l *arrayList) All(predicate func(element interface{}) bool) bool {
+    _, found := l.Find(func(element interface{}) bool { return !predicate(element) })
+
+    return !found
+}
+
 // Map returns a new ArrayList containing the resulting elements of applying the given function to the elements of this
-// ArrayList.
+// ArrayList. The backing slice of the new ArrayList is allocated up front with the size of this ArrayList.
 func (l *arrayList) Map(mapper func(element interface{}) interface{}) List {
-    list := NewArrayList()
+    list := newArrayListWithCapacity(l.Size())
 
-    l.ForEach(func(element interface{}) { list.Add(mapper(element)) })
+    l.ForEach(func(element interface{}) { list.elements = append(list.elements, mapper(element)) })
 
     return list
 }
 
+// MapError returns a new ArrayList containing the resulting elements of applying the given function to the elements of
+// this ArrayList. If the function returns an error, mapping stops and the error is returned along with the ArrayList of
+// the elements mapped before the failure.
+func (l *arrayList) MapError(mapper func(element interface{}) (interface{}, error)) (List, error) {
+    list := newArrayListWithCapacity(l.Size())
+
+    for _, v := range l.elements {
+        element, err := mapper(v)
+        if err != nil {
+            return list, err
+        }
+        list.elements = append(list.elements, element)
+    }
+
+    return list, nil
+}
+
 // ForEach performs the provided consumer function for each element of the ArrayList.
 func (l *arrayList) ForEach(consumer func(element interface{})) {
     for _, v := range l.elements {
@@ -226,6 +416,24 @@ func (l *arrayList) ForEach(consumer func(element interface{})) {
     }
 }
 
+// Stream returns a channel over which the elements of the ArrayList are sent in iteration order, which is closed once
+// every element has been sent. The ArrayList must not be modified until the channel is closed.
+func (l *arrayList) Stream() <-chan interface{} {
+    return collection.Stream(l, nil)
+}
+
+// ToSlice copies the elements of the ArrayList into the slice pointed to by the provided destination (e.g. *[]string),
+// replacing its contents. The returned error will be non-nil if the destination is not a non-nil pointer to a slice, or
+// if any element is not assignable to the element type of the slice.
+func (l *arrayList) ToSlice(dst interface{}) error {
+    return toSlice(l.Values(), dst)
+}
+
+// CopyTo copies as many elements of the ArrayList as fit into the provided slice, and returns the number copied.
+func (l *arrayList) CopyTo(dst []interface{}) int {
+    return copy(dst, l.elements)
+}
+
 // Size returns the number of elements in the ArrayList.
 func (l *arrayList) Size() int {
     return len(l.elements)
@@ -251,6 +459,24 @@ func (l *arrayList) Contains(element interface{}) bool {
     return false
 }
 
+// ContainsAll returns true if every element of the provided collection exists in the ArrayList, otherwise false is
+// returned. A nil or empty collection is contained by every ArrayList.
+func (l *arrayList) ContainsAll(other collection.Collection) bool {
+    return collection.ContainsAll(l, other)
+}
+
+// ContainsAllOf returns a map from each of the provided elements to true if it exists in the ArrayList, otherwise
+// false. Elements that are not hashable cannot be keys of the map, and are omitted.
+func (l *arrayList) ContainsAllOf(elements []interface{}) map[interface{}]bool {
+    return collection.ContainsAllOf(l, elements)
+}
+
+// EqualsIgnoreOrder returns true if the provided List contains the same elements as the ArrayList with the same number
+// of occurrences, regardless of their positions. A nil List is not equal to any List.
+func (l *arrayList) EqualsIgnoreOrder(other List) bool {
+    return other != nil && collection.Equals(l, other)
+}
+
 // Values returns a slice containing the elements in the List in the iteration order.
 func (l *arrayList) Values() []interface{} {
     elements := make([]interface{}, l.Size())
@@ -259,21 +485,107 @@ func (l *arrayList) Values() []interface{} {
     return elements
 }
 
+// Clone returns a new ArrayList containing the elements of the ArrayList. The elements themselves are not copied.
+func (l *arrayList) Clone() collection.Collection {
+    return &arrayList{ elements: l.Values() }
+}
+
+// UnsafeSlice returns the slice backing the ArrayList without copying it. Assigning to the elements of the returned
+// slice modifies the ArrayList, and the slice may no longer reflect the ArrayList once it is modified (e.g. by an
+// insertion that grows the backing slice).
+func (l *arrayList) UnsafeSlice() []interface{} {
+    return l.elements
+}
+
+// Iterator returns an Iterator positioned before the first element of the ArrayList.
+func (l *arrayList) Iterator() collection.Iterator {
+    return newIndexIterator(l)
+}
+
+// ReverseIterator returns an Iterator positioned after the last element of the ArrayList, which traverses the elements
+// from the last to the first.
+func (l *arrayList) ReverseIterator() collection.Iterator {
+    return newReverseIndexIterator(l)
+}
+
 // String returns a string representation of the ArrayList in it's current state.
 func (l *arrayList) String() string {
-    if l.Size() == 0 {
-        return "[]"
+    return collection.Format(l)
+}
+
+// StringQuoted returns a string representation of the ArrayList in which string elements are quoted and nil elements are
+// rendered as nil.
+func (l *arrayList) StringQuoted() string {
+    return stringQuoted(l.Values())
+}
+
+// MarshalJSON encodes the ArrayList as a JSON array of its elements. Each element must itself be serializable by the
+// encoding/json package.
+func (l *arrayList) MarshalJSON() ([]byte, error) {
+    data, err := json.Marshal(l.elements)
+    if err != nil {
+        return nil, errors.Wrap(err, "could not encode list")
     }
 
-    elements := make([]string, 0, l.Size())
-    l.ForEach(func(element interface{}) {
-        elements = append(elements, fmt.Sprintf("%v", element))
-    })
+    return data, nil
+}
+
+// UnmarshalJSON replaces the elements of the ArrayList with the elements decoded from the provided JSON array. Elements
+// are decoded into the default types of the encoding/json package (e.g. float64 for numbers, map[string]interface{} for
+// objects, and []interface{} for arrays). Decoding a JSON null leaves the ArrayList unchanged.
+func (l *arrayList) UnmarshalJSON(data []byte) error {
+    var elements []interface{}
+    if err := json.Unmarshal(data, &elements); err != nil {
+        return errors.Wrap(err, "could not decode list")
+    }
+
+    if elements != nil {
+        l.elements = elements
+    }
+
+    return nil
+}
+
+// GobEncode encodes the elements of the ArrayList with the encoding/gob package. Since the elements are encoded as
+// interface values, the concrete type of each element must be registered with gob.Register, with the exception of the
+// basic types (e.g. string, int, and []byte) which are registered by default.
+func (l *arrayList) GobEncode() ([]byte, error) {
+    var buffer bytes.Buffer
+
+    if err := gob.NewEncoder(&buffer).Encode(l.elements); err != nil {
+        return nil, errors.Wrap(err, "could not encode list")
+    }
 
-    return "[" + strings.Join(elements, ", ") + "]"
+    return buffer.Bytes(), nil
+}
+
+// GobDecode replaces the elements of the ArrayList with the elements decoded from the provided data, which must have
+// been encoded by GobEncode.
+func (l *arrayList) GobDecode(data []byte) error {
+    var elements []interface{}
+    if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elements); err != nil {
+        return errors.Wrap(err, "could not decode list")
+    }
+
+    if elements == nil {
+        elements = make([]interface{}, 0)
+    }
+    l.elements = elements
+
+    return nil
 }
 
 func (l *arrayList) checkBounds(index int) error {
+    if index < 0 || index >= l.Size() {
+        return errors.Errorf("index out of bounds [*ArrayList.Size() = %v, requested index = %v]", l.Size(), index)
+    }
+
+    return nil
+}
+
+// checkInsertBounds returns a non-nil error if the provided index is not a position at which an element can be
+// inserted, which unlike checkBounds includes the position one past the last element.
+func (l *arrayList) checkInsertBounds(index int) error {
     if index < 0 || index > l.Size() {
         return errors.Errorf("index out of bounds [*ArrayList.Size() = %v, requested index = %v]", l.Size(), index)
     }
@@ -281,6 +593,20 @@ func (l *arrayList) checkBounds(index int) error {
     return nil
 }
 
+func (l *arrayList) checkRange(from int, to int) error {
+    if from < 0 || from > to || to > l.Size() {
+        return errors.Errorf("range out of bounds [*ArrayList.Size() = %v, requested range = [%v, %v)]", l.Size(), from, to)
+    }
+
+    return nil
+}
+
+// upperBound returns the index of the first element that is greater than the provided element according to the
+// provided comparator.
+func (l *arrayList) upperBound(element interface{}, less func(a, b interface{}) bool) int {
+    return sort.Search(l.Size(), func(i int) bool { return less(element, l.elements[i]) })
+}
+
 func (l *arrayList) findFirst(element interface{}) (int, error) {
     for i, v := range l.elements {
         if reflect.DeepEqual(v, element) {
@@ -0,0 +1,91 @@
+package list
+
+import (
+    "fmt"
+    "testing"
+)
+
+// benchmarkSizes are the numbers of elements the benchmarks are run with, so that operations whose cost grows with the
+// size of the List are surfaced by the growth of their time per operation.
+var benchmarkSizes = []int{ 1000, 10000, 100000 }
+
+func BenchmarkArrayList_Add(b *testing.B) {
+    for i := 0; i < b.N; i++ {
+        list := NewArrayList()
+        for j := 0; j < 1000; j++ {
+            _ = list.Add(j)
+        }
+    }
+}
+
+func BenchmarkArrayList_Remove(b *testing.B) {
+    benchmarkWithSizes(b, NewArrayList, func(b *testing.B, list List, n int) {
+        for i := 0; i < b.N; i++ {
+            list.Remove(i % n)
+            _ = list.Add(i % n)
+        }
+    })
+}
+
+func BenchmarkArrayList_Values(b *testing.B) {
+    benchmarkWithSizes(b, NewArrayList, func(b *testing.B, list List, n int) {
+        for i := 0; i < b.N; i++ {
+            list.Values()
+        }
+    })
+}
+
+func BenchmarkArrayList_ValueWithIndex(b *testing.B) {
+    benchmarkWithSizes(b, NewArrayList, func(b *testing.B, list List, n int) {
+        for i := 0; i < b.N; i++ {
+            _, _ = list.ValueWithIndex(n / 2)
+        }
+    })
+}
+
+func BenchmarkLinkedList_ValueWithIndex(b *testing.B) {
+    benchmarkWithSizes(b, NewLinkedList, func(b *testing.B, list List, n int) {
+        for i := 0; i < b.N; i++ {
+            _, _ = list.ValueWithIndex(n / 2)
+        }
+    })
+}
+
+// BenchmarkList_Size reports the time of Size for every List implementation, which should not grow with the number of
+// elements.
+func BenchmarkList_Size(b *testing.B) {
+    for name, newList := range map[string]func() List{
+        "ArrayList":        NewArrayList,
+        "LinkedList":       NewLinkedList,
+        "CircularList":     func() List { return NewCircularList(benchmarkSizes[len(benchmarkSizes) - 1]) },
+        "IndexedArrayList": NewIndexedArrayList,
+        "CopyOnWriteList":  NewCopyOnWriteList,
+        "SynchronizedList": func() List { return NewSynchronizedList(NewArrayList()) },
+    } {
+        b.Run(name, func(b *testing.B) {
+            benchmarkWithSizes(b, newList, func(b *testing.B, list List, n int) {
+                for i := 0; i < b.N; i++ {
+                    list.Size()
+                }
+            })
+        })
+    }
+}
+
+// benchmarkWithSizes runs the provided benchmark as a sub-benchmark for each of the benchmark sizes, with a List created
+// by the provided function holding that number of elements.
+func benchmarkWithSizes(b *testing.B, newList func() List, benchmark func(b *testing.B, list List, n int)) {
+    for _, n := range benchmarkSizes {
+        list   := newList()
+        values := make([]interface{}, n)
+        for i := range values {
+            values[i] = i
+        }
+        _ = list.AddValues(values...)
+
+        b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+            b.ResetTimer()
+            benchmark(b, list, n)
+        })
+    }
+}
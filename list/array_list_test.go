@@ -1,10 +1,18 @@
 package list
 
 import (
+    "bytes"
+    "encoding/gob"
+    "encoding/json"
+    "fmt"
+    "reflect"
+    "runtime"
     "strings"
     "testing"
+    "time"
 
     "github.com/2speed/go-collection"
+    "github.com/pkg/errors"
 )
 
 type element struct {
@@ -88,6 +96,19 @@ func TestArrayList_Add(t *testing.T) {
         assertContains(t, list, newElements[2], true)
         assertIndex(t, list, newElements[2], newElements[2].position)
     })
+
+    t.Run("AddValues", func(t *testing.T) {
+        list := NewArrayListOf([]interface{}{ "samus", "yoshi" })
+
+        assertError(t, list.AddValues("gumball", "luffy", "chopper"), nil)
+        assertContentEquals(t, list, "[samus, yoshi, gumball, luffy, chopper]")
+
+        values := []interface{}{ "snorlax", "mega man" }
+        assertError(t, list.AddValues(values...), nil)
+        assertError(t, list.AddValues(), nil)
+        assertSize(t, list, 7)
+        assertContentEquals(t, list, "[samus, yoshi, gumball, luffy, chopper, snorlax, mega man]")
+    })
 }
 
 func TestArrayList_Remove(t *testing.T) {
@@ -151,6 +172,34 @@ func TestArrayList_Remove(t *testing.T) {
         assertIndex(t, list, elements[5], 4)
     })
 
+    t.Run("RemoveWithIndexReleasesElement", func(t *testing.T) {
+        list      := NewArrayList()
+        collected := make(chan struct{})
+
+        func() {
+            element := &[1 << 20]byte{}
+            runtime.SetFinalizer(element, func(*[1 << 20]byte) { close(collected) })
+
+            _ = list.Add("samus")
+            _ = list.Add(element)
+            _, _ = list.RemoveWithIndex(1)
+        }()
+
+        for i := 0; i < 10; i++ {
+            runtime.GC()
+
+            select {
+            case <-collected:
+                assertSize(t, list, 1)
+                return
+            case <-time.After(10 * time.Millisecond):
+            }
+        }
+
+        runtime.KeepAlive(list)
+        t.Error("expected removed element to be collectable")
+    })
+
     t.Run("Clear", func(t *testing.T) {
         list := NewArrayListOf(elements)
 
@@ -210,6 +259,541 @@ func TestArrayList_Functional(t *testing.T) {
         assertContains(t, list, element{ value: "YOSHI", position: 5 }, true)
     })
 
+    t.Run("FilterIndexed", func(t *testing.T) {
+        values := []interface{}{ "a", "b", "c", "d", "e" }
+
+        for name, list := range map[string]List{
+            "ArrayList":        NewArrayListOf(values),
+            "LinkedList":       NewLinkedListOf(values),
+            "SynchronizedList": NewSynchronizedList(NewArrayListOf(values)),
+            "ImmutableList":    NewImmutableList(NewArrayListOf(values)),
+        } {
+            indices  := make([]int, 0)
+            filtered := list.FilterIndexed(func(index int, element interface{}) bool {
+                indices = append(indices, index)
+                return index % 2 == 0
+            })
+
+            if !reflect.DeepEqual(indices, []int{ 0, 1, 2, 3, 4 }) {
+                t.Errorf("%s: expected ascending indices, but found '%v'", name, indices)
+            }
+            assertSize(t, filtered, 3)
+            assertContentEquals(t, filtered, "[a, c, e]")
+            assertContentEquals(t, list, "[a, b, c, d, e]")
+        }
+    })
+
+    t.Run("MapError", func(t *testing.T) {
+        errNotString := errors.New("element is not a string")
+        toUpperCase  := func(v interface{}) (interface{}, error) {
+            s, ok := v.(string)
+            if !ok {
+                return nil, errNotString
+            }
+            return strings.ToUpper(s), nil
+        }
+
+        for name, list := range map[string]List{
+            "ArrayList":        NewArrayListOf([]interface{}{ "a", "b", "c", "d" }),
+            "LinkedList":       NewLinkedListOf([]interface{}{ "a", "b", "c", "d" }),
+            "SynchronizedList": NewSynchronizedList(NewArrayListOf([]interface{}{ "a", "b", "c", "d" })),
+        } {
+            mapped, err := list.MapError(toUpperCase)
+            assertError(t, err, nil)
+            assertContentEquals(t, mapped, "[A, B, C, D]")
+
+            _ = list.AddWithIndex(2, 42)
+            mapped, err = list.MapError(toUpperCase)
+            if err != errNotString {
+                t.Errorf("%s: expected '%v', but found '%v'", name, errNotString, err)
+            }
+            assertContentEquals(t, mapped, "[A, B]")
+        }
+    })
+
+    t.Run("Apply", func(t *testing.T) {
+        toUpperCase := func(v interface{}) interface{} { return strings.ToUpper(v.(string)) }
+
+        wrapped := NewCircularListWithOverwrite(4)
+        _ = wrapped.AddValues("z", "a", "b", "c", "d")
+
+        for _, list := range []List{
+            NewArrayListOf([]interface{}{ "a", "b", "c", "d" }),
+            NewLinkedListOf([]interface{}{ "a", "b", "c", "d" }),
+            wrapped,
+            NewIndexedArrayList(),
+            NewCopyOnWriteList(),
+            NewSynchronizedList(NewArrayListOf([]interface{}{ "a", "b", "c", "d" })),
+        } {
+            name := fmt.Sprintf("%T", list)
+            if list.IsEmpty() {
+                _ = list.AddValues("a", "b", "c", "d")
+            }
+
+            mapped := list.Map(toUpperCase)
+            assertContentEquals(t, mapped, "[A, B, C, D]")
+            assertContentEquals(t, list, "[a, b, c, d]")
+
+            list.Apply(toUpperCase)
+            assertContentEquals(t, list, "[A, B, C, D]")
+
+            if index, err := list.IndexOf("C"); err != nil || index != 2 {
+                t.Errorf("%s: expected index of '2', but found '%d' (%v)", name, index, err)
+            }
+            assertContains(t, list, "c", false)
+        }
+
+        list    := NewArrayListOf([]interface{}{ "a", "b" }).(SliceBacked)
+        backing := list.UnsafeSlice()
+        list.Apply(toUpperCase)
+
+        if backing[0] != "A" || backing[1] != "B" {
+            t.Errorf("expected the backing slice to be overwritten, but found '%v'", backing)
+        }
+    })
+
+    t.Run("Find", func(t *testing.T) {
+        isElement := func(v interface{}) bool { _, ok := v.(element); return ok }
+        isNumber  := func(v interface{}) bool { _, ok := v.(int); return ok }
+
+        for _, list := range []List{
+            NewArrayListOf(elements),
+            NewLinkedListOf(elements),
+            NewCopyOnWriteList(),
+            NewSynchronizedList(NewArrayListOf(elements)),
+        } {
+            name := fmt.Sprintf("%T", list)
+            if list.IsEmpty() {
+                _ = list.AddValues(elements...)
+            }
+
+            visited := 0
+            actual, found := list.Find(func(v interface{}) bool {
+                visited++
+                return isElement(v)
+            })
+
+            if !found || actual != elements[1] {
+                t.Errorf("%s: expected '%v', but found '%v, %t'", name, elements[1], actual, found)
+            }
+            if visited != 2 {
+                t.Errorf("%s: expected the traversal to stop after '2' elements, but visited '%d'", name, visited)
+            }
+
+            if actual, found := list.Find(isNumber); found || actual != nil {
+                t.Errorf("%s: expected no match, but found '%v, %t'", name, actual, found)
+            }
+        }
+    })
+}
+
+func TestArrayList_RemoveRange(t *testing.T) {
+    values := []interface{}{ "a", "b", "c", "d", "e", "f" }
+
+    for _, c := range []struct {
+        name     string
+        from, to int
+        expected string
+    }{
+        { name: "Head",   from: 0, to: 2, expected: "[c, d, e, f]" },
+        { name: "Middle", from: 2, to: 5, expected: "[a, b, f]" },
+        { name: "Tail",   from: 4, to: 6, expected: "[a, b, c, d]" },
+        { name: "All",    from: 0, to: 6, expected: "[]" },
+        { name: "Empty",  from: 3, to: 3, expected: "[a, b, c, d, e, f]" },
+    } {
+        t.Run(c.name, func(t *testing.T) {
+            for name, list := range map[string]List{
+                "ArrayList":        NewArrayListOf(values),
+                "LinkedList":       NewLinkedListOf(values),
+                "IndexedArrayList": NewIndexedArrayList(),
+            } {
+                if name == "IndexedArrayList" {
+                    _ = list.AddAll(NewArrayListOf(values))
+                }
+
+                assertError(t, list.RemoveRange(c.from, c.to), nil)
+                assertSize(t, list, len(values) - (c.to - c.from))
+                assertContentEquals(t, list, c.expected)
+            }
+        })
+    }
+
+    t.Run("OutOfBounds", func(t *testing.T) {
+        list := NewArrayListOf(values)
+
+        for _, r := range [][]int{ { -1, 2 }, { 3, 2 }, { 4, 7 } } {
+            if err := list.RemoveRange(r[0], r[1]); err == nil {
+                t.Errorf("expected error for range [%d, %d)", r[0], r[1])
+            }
+        }
+        assertContentEquals(t, list, "[a, b, c, d, e, f]")
+    })
+}
+
+func TestArrayList_InsertSorted(t *testing.T) {
+    values := []interface{}{ 2, 4, 6, 8 }
+    less   := func(a, b interface{}) bool { return a.(int) < b.(int) }
+
+    for _, c := range []struct {
+        name     string
+        element  int
+        index    int
+        expected string
+    }{
+        { name: "Front",      element: 1, index: 0, expected: "[1, 2, 4, 6, 8]" },
+        { name: "Middle",     element: 5, index: 2, expected: "[2, 4, 5, 6, 8]" },
+        { name: "End",        element: 9, index: 4, expected: "[2, 4, 6, 8, 9]" },
+        { name: "Equivalent", element: 4, index: 2, expected: "[2, 4, 4, 6, 8]" },
+    } {
+        t.Run(c.name, func(t *testing.T) {
+            for name, list := range map[string]List{
+                "ArrayList":        NewArrayListOf(values),
+                "LinkedList":       NewLinkedListOf(values),
+                "IndexedArrayList": NewIndexedArrayList(),
+            } {
+                if name == "IndexedArrayList" {
+                    _ = list.AddAll(NewArrayListOf(values))
+                }
+
+                if index := list.InsertSorted(c.element, less); index != c.index {
+                    t.Errorf("%s: expected index %d, got %d", name, c.index, index)
+                }
+                assertContentEquals(t, list, c.expected)
+            }
+        })
+    }
+
+    t.Run("Empty", func(t *testing.T) {
+        list := NewArrayList()
+
+        if index := list.InsertSorted(3, less); index != 0 {
+            t.Errorf("expected index 0, got %d", index)
+        }
+        assertContentEquals(t, list, "[3]")
+    })
+}
+
+func TestArrayList_Fill(t *testing.T) {
+    t.Run("Fill", func(t *testing.T) {
+        list := NewArrayListOf([]interface{}{ 1, 2, 3, 4 })
+        list.Fill(0)
+
+        assertSize(t, list, 4)
+        assertContentEquals(t, list, "[0, 0, 0, 0]")
+    })
+
+    t.Run("FillRange", func(t *testing.T) {
+        list := NewArrayListOf([]interface{}{ 1, 2, 3, 4, 5 })
+        err  := list.FillRange(1, 3, 0)
+
+        assertError(t, err, nil)
+        assertSize(t, list, 5)
+        assertContentEquals(t, list, "[1, 0, 0, 4, 5]")
+
+        assertError(t, list.FillRange(5, 5, 9), nil)
+        assertContentEquals(t, list, "[1, 0, 0, 4, 5]")
+    })
+
+    t.Run("FillRangeOutOfBounds", func(t *testing.T) {
+        list := NewArrayListOf([]interface{}{ 1, 2, 3 })
+
+        for _, r := range [][]int{ { -1, 2 }, { 2, 1 }, { 1, 4 } } {
+            if err := list.FillRange(r[0], r[1], 0); err == nil {
+                t.Errorf("expected error for range [%d, %d)", r[0], r[1])
+            }
+        }
+        assertContentEquals(t, list, "[1, 2, 3]")
+    })
+}
+
+func BenchmarkArrayList_Map(b *testing.B) {
+    list := benchmarkList(100000)
+
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        list.Map(func(element interface{}) interface{} { return element })
+    }
+}
+
+func BenchmarkArrayList_Filter(b *testing.B) {
+    list := benchmarkList(100000)
+
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        list.Filter(func(element interface{}) bool { return element.(int) % 2 == 0 })
+    }
+}
+
+func benchmarkList(size int) List {
+    list := NewArrayList()
+    for i := 0; i < size; i++ {
+        _ = list.Add(i)
+    }
+
+    return list
+}
+
+func TestArrayList_StringQuoted(t *testing.T) {
+    for _, c := range []struct {
+        name     string
+        elements []interface{}
+        expected string
+    }{
+        { name: "Empty",  elements: []interface{}{},                              expected: `[]` },
+        { name: "Commas", elements: []interface{}{ "a, b", "c" },                 expected: `["a, b", "c"]` },
+        { name: "Escape", elements: []interface{}{ "say \"hi\"\n" },              expected: `["say \"hi\"\n"]` },
+        { name: "Nil",    elements: []interface{}{ 1, nil, "nil" },               expected: `[1, nil, "nil"]` },
+        { name: "Nested", elements: []interface{}{ "a", NewArrayListOf("b, c") }, expected: `["a", ["b, c"]]` },
+    } {
+        t.Run(c.name, func(t *testing.T) {
+            list := NewArrayListOf(c.elements)
+
+            if actual := list.StringQuoted(); actual != c.expected {
+                t.Errorf("expected %s, got %s", c.expected, actual)
+            }
+        })
+    }
+
+    t.Run("Compatibility", func(t *testing.T) {
+        assertContentEquals(t, NewArrayListOf([]interface{}{ "a, b", nil }), "[a, b, <nil>]")
+    })
+}
+
+func TestArrayList_ContainsAll(t *testing.T) {
+    list := NewArrayListOf([]interface{}{ "samus", "luffy", "yoshi", "jigglypuff" })
+
+    for _, c := range []struct {
+        name     string
+        other    collection.Collection
+        expected bool
+    }{
+        { name: "Subset",   other: NewArrayListOf([]interface{}{ "yoshi", "samus" }),                                 expected: true },
+        { name: "Equal",    other: NewArrayListOf([]interface{}{ "jigglypuff", "luffy", "samus", "yoshi" }),          expected: true },
+        { name: "Superset", other: NewArrayListOf([]interface{}{ "samus", "luffy", "yoshi", "jigglypuff", "kirby" }), expected: false },
+        { name: "Disjoint", other: NewArrayListOf([]interface{}{ "kirby", "mega man" }),                              expected: false },
+        { name: "Empty",    other: NewArrayList(),                                                                    expected: true },
+        { name: "Nil",      other: nil,                                                                               expected: true },
+    } {
+        if actual := list.ContainsAll(c.other); actual != c.expected {
+            t.Errorf("%s: expected '%t', but found '%t'", c.name, c.expected, actual)
+        }
+    }
+}
+
+func TestArrayList_ContainsAllOf(t *testing.T) {
+    values := []interface{}{ "samus", "luffy", 42, nil }
+
+    for name, list := range map[string]List{
+        "ArrayList":        NewArrayListOf(values),
+        "LinkedList":       NewLinkedListOf(values),
+        "IndexedArrayList": NewIndexedArrayList(),
+    } {
+        if name == "IndexedArrayList" {
+            _ = list.AddAll(NewArrayListOf(values))
+        }
+
+        actual   := list.ContainsAllOf([]interface{}{ "samus", "kirby", 42, 7, nil, []int{ 1 } })
+        expected := map[interface{}]bool{ "samus": true, "kirby": false, 42: true, 7: false, nil: true }
+        if !reflect.DeepEqual(actual, expected) {
+            t.Errorf("%s: expected '%v', but found '%v'", name, expected, actual)
+        }
+    }
+}
+
+func TestArrayList_Stream(t *testing.T) {
+    values := make([]interface{}, 0, 3 * collection.StreamBufferSize)
+    for i := 0; i < cap(values); i++ {
+        values = append(values, i)
+    }
+
+    for name, list := range map[string]List{
+        "ArrayList":        NewArrayListOf(values),
+        "LinkedList":       NewLinkedListOf(values),
+        "SynchronizedList": NewSynchronizedList(NewArrayListOf(values)),
+        "CopyOnWriteList":  NewCopyOnWriteList(),
+        "Empty":            NewArrayList(),
+    } {
+        if name == "CopyOnWriteList" {
+            _ = list.AddAll(NewArrayListOf(values))
+        }
+
+        streamed := make([]interface{}, 0)
+        for element := range list.Stream() {
+            streamed = append(streamed, element)
+        }
+
+        if !reflect.DeepEqual(streamed, list.Values()) {
+            t.Errorf("%s: expected streamed elements to equal Values(), but found '%v'", name, streamed)
+        }
+    }
+}
+
+func TestArrayList_RemoveAll(t *testing.T) {
+    list := NewArrayListOf([]interface{}{ "samus", "luffy", "yoshi", "samus", "jigglypuff", "kirby" })
+
+    if count := list.RemoveAll(NewArrayListOf([]interface{}{ "samus", "kirby", "mega man" })); count != 3 {
+        t.Errorf("expected '3' elements removed, but found '%d'", count)
+    }
+
+    assertSize(t, list, 3)
+    assertContains(t, list, "samus", false)
+    assertContains(t, list, "kirby", false)
+    assertContains(t, list, "luffy", true)
+    assertContains(t, list, "yoshi", true)
+    assertContains(t, list, "jigglypuff", true)
+
+    if count := list.RemoveAll(nil); count != 0 {
+        t.Errorf("expected '0' elements removed, but found '%d'", count)
+    }
+
+    if count := list.RemoveAll(list); count != 3 || !list.IsEmpty() {
+        t.Errorf("expected all '3' elements removed, but found '%d'", count)
+    }
+}
+
+func TestArrayList_RetainAll(t *testing.T) {
+    list := NewArrayListOf([]interface{}{ "samus", "luffy", "yoshi", "samus", "jigglypuff", "kirby" })
+
+    if count := list.RetainAll(NewArrayListOf([]interface{}{ "samus", "kirby", "mega man" })); count != 3 {
+        t.Errorf("expected '3' elements removed, but found '%d'", count)
+    }
+
+    assertSize(t, list, 3)
+    assertContentEquals(t, list, "[samus, samus, kirby]")
+
+    if count := list.RetainAll(list); count != 0 {
+        t.Errorf("expected '0' elements removed, but found '%d'", count)
+    }
+
+    if count := list.RetainAll(nil); count != 3 || !list.IsEmpty() {
+        t.Errorf("expected all '3' elements removed, but found '%d'", count)
+    }
+}
+
+func TestArrayList_JSON(t *testing.T) {
+    list := NewArrayListOf([]interface{}{ "samus", 64, true, nil, []interface{}{ "yoshi", 2.5 } })
+
+    data, err := json.Marshal(list)
+    assertError(t, err, nil)
+    if actual := string(data); actual != `["samus",64,true,null,["yoshi",2.5]]` {
+        t.Errorf("expected JSON of '[\"samus\",64,true,null,[\"yoshi\",2.5]]', but found '%s'", actual)
+    }
+
+    decoded := NewArrayListOf("luffy")
+    assertError(t, json.Unmarshal(data, decoded), nil)
+    assertSize(t, decoded, 5)
+    assertContentEquals(t, decoded, "[samus, 64, true, <nil>, [yoshi 2.5]]")
+    assertContains(t, decoded, float64(64), true)
+
+    data, err = json.Marshal(NewArrayList())
+    assertError(t, err, nil)
+    if actual := string(data); actual != "[]" {
+        t.Errorf("expected JSON of '[]', but found '%s'", actual)
+    }
+
+    assertError(t, json.Unmarshal([]byte("null"), decoded), nil)
+    assertSize(t, decoded, 5)
+
+    for _, invalid := range []string{ `{"samus":1}`, `[1,`, `"samus"` } {
+        if err := json.Unmarshal([]byte(invalid), decoded); err == nil {
+            t.Errorf("expected error when decoding '%s'", invalid)
+        }
+    }
+}
+
+func TestArrayList_Gob(t *testing.T) {
+    source := NewArrayListOf([]interface{}{ "samus", 64, 2.5, []byte("luffy"), "samus" })
+
+    var buffer bytes.Buffer
+    assertError(t, gob.NewEncoder(&buffer).Encode(source), nil)
+
+    target := NewArrayListOf("yoshi")
+    assertError(t, gob.NewDecoder(&buffer).Decode(target), nil)
+
+    if !reflect.DeepEqual(target.Values(), source.Values()) {
+        t.Errorf("expected values '%v', but found '%v'", source.Values(), target.Values())
+    }
+
+    buffer.Reset()
+    assertError(t, gob.NewEncoder(&buffer).Encode(NewArrayList()), nil)
+    assertError(t, gob.NewDecoder(&buffer).Decode(target), nil)
+    assertSize(t, target, 0)
+
+    if err := target.(gob.GobDecoder).GobDecode([]byte("samus")); err == nil {
+        t.Error("expected error when decoding invalid data")
+    }
+}
+
+func TestArrayList_ToSlice(t *testing.T) {
+    t.Run("Typed", func(t *testing.T) {
+        var names []string
+        err := NewArrayListOf([]interface{}{ "samus", "luffy", "yoshi" }).ToSlice(&names)
+
+        assertError(t, err, nil)
+        if !reflect.DeepEqual(names, []string{ "samus", "luffy", "yoshi" }) {
+            t.Errorf("expected '[samus luffy yoshi]', but found '%v'", names)
+        }
+    })
+
+    t.Run("Interface", func(t *testing.T) {
+        var values []fmt.Stringer
+        err := NewArrayListOf([]interface{}{ NewArrayListOf(1), nil }).ToSlice(&values)
+
+        assertError(t, err, nil)
+        if len(values) != 2 || values[0].String() != "[1]" || values[1] != nil {
+            t.Errorf("expected '[[1] <nil>]', but found '%v'", values)
+        }
+    })
+
+    t.Run("Mixed", func(t *testing.T) {
+        names := []string{ "kirby" }
+        err   := NewArrayListOf([]interface{}{ "samus", 64, "yoshi" }).ToSlice(&names)
+
+        if err == nil {
+            t.Error("expected error when copying an int into a []string")
+        }
+
+        if !reflect.DeepEqual(names, []string{ "kirby" }) {
+            t.Errorf("expected destination to be unchanged, but found '%v'", names)
+        }
+    })
+
+    t.Run("Destination", func(t *testing.T) {
+        var names []string
+        for _, dst := range []interface{}{ nil, names, (*[]string)(nil), new(string) } {
+            if err := NewArrayListOf("samus").ToSlice(dst); err == nil {
+                t.Errorf("expected error for destination of type '%T'", dst)
+            }
+        }
+    })
+}
+
+func TestArrayList_UnsafeSlice(t *testing.T) {
+    list, ok := NewArrayListOf([]interface{}{ "samus", "luffy", "yoshi" }).(SliceBacked)
+    if !ok {
+        t.Fatal("expected ArrayList to be SliceBacked")
+    }
+
+    slice := list.UnsafeSlice()
+    if len(slice) != 3 || slice[0] != "samus" || slice[2] != "yoshi" {
+        t.Errorf("expected '[samus luffy yoshi]', but found '%v'", slice)
+    }
+
+    slice[1] = "kirby"
+    assertContentEquals(t, list, "[samus, kirby, yoshi]")
+
+    values := list.Values()
+    values[1] = "mario"
+    assertContentEquals(t, list, "[samus, kirby, yoshi]")
+
+    if _, ok := NewLinkedList().(SliceBacked); ok {
+        t.Error("expected LinkedList not to be SliceBacked")
+    }
+    if _, ok := NewIndexedArrayList().(SliceBacked); ok {
+        t.Error("expected IndexedArrayList not to be SliceBacked")
+    }
 }
 
 func assertContains(t *testing.T, collection collection.Collection, value interface{}, expected bool) {
@@ -0,0 +1,600 @@
+package list
+
+import (
+    "reflect"
+    "sort"
+
+    "github.com/2speed/go-collection"
+    "github.com/pkg/errors"
+)
+
+// CircularList is a bounded implementation of a List whose elements are maintained by a fixed-capacity ring buffer.
+// Elements are read and removed in first-in-first-out order. When the CircularList has reached capacity, the insertion
+// of further elements either fails with collection.ErrorCapacityReached, or discards the oldest element to make room,
+// depending on how the CircularList was created. CircularList implements collection.Bounded, and does not make any
+// guarantees for concurrent access.
+type circularList struct {
+    elements  []interface{}
+    front     int
+    size      int
+    overwrite bool
+}
+
+// NewCircularList creates a new CircularList with the provided capacity. Once the CircularList has reached capacity,
+// inserting further elements returns collection.ErrorCapacityReached.
+func NewCircularList(capacity int) List {
+    return newCircularList(capacity, false)
+}
+
+// NewCircularListWithOverwrite creates a new CircularList with the provided capacity. Once the CircularList has reached
+// capacity, inserting further elements discards the element at the opposite end of the CircularList. More
+// specifically, Add and AddLast discard the first element, and AddFirst discards the last element.
+func NewCircularListWithOverwrite(capacity int) List {
+    return newCircularList(capacity, true)
+}
+
+func newCircularList(capacity int, overwrite bool) *circularList {
+    if capacity < 0 {
+        capacity = 0
+    }
+
+    return &circularList{
+        elements:  make([]interface{}, capacity),
+        overwrite: overwrite,
+    }
+}
+
+// Add inserts the provided element at the end of the CircularList. The returned error will be
+// collection.ErrorCapacityReached if the CircularList has reached capacity and does not overwrite.
+func (l *circularList) Add(element interface{}) error {
+    return l.AddLast(element)
+}
+
+// AddAll inserts all elements from the provided Collection at the end of the CircularList. The returned error will be
+// collection.ErrorCapacityReached if the elements would exceed the capacity of a CircularList that does not overwrite,
+// in which case no elements are inserted.
+func (l *circularList) AddAll(c collection.Collection) error {
+    if c == nil {
+        return nil
+    }
+
+    return l.AddValues(c.Values()...)
+}
+
+// AddFirst inserts the provided element at the front (index == 0) of the CircularList. The returned error will be
+// collection.ErrorCapacityReached if the CircularList has reached capacity and does not overwrite.
+func (l *circularList) AddFirst(element interface{}) error {
+    if l.IsFull() {
+        if !l.overwrite || l.Capacity() == 0 {
+            return collection.ErrorCapacityReached
+        }
+        l.removeAt(l.size - 1)
+    }
+
+    l.insertAt(0, element)
+
+    return nil
+}
+
+// AddLast inserts the provided element at the end of the CircularList (index == CircularList.Size()). The returned
+// error will be collection.ErrorCapacityReached if the CircularList has reached capacity and does not overwrite.
+func (l *circularList) AddLast(element interface{}) error {
+    if l.IsFull() {
+        if !l.overwrite || l.Capacity() == 0 {
+            return collection.ErrorCapacityReached
+        }
+        l.removeAt(0)
+    }
+
+    l.insertAt(l.size, element)
+
+    return nil
+}
+
+// AddValues inserts the provided elements at the end of the CircularList in the order they are provided. The returned
+// error will be collection.ErrorCapacityReached if the elements would exceed the capacity of a CircularList that does
+// not overwrite, in which case no elements are inserted.
+func (l *circularList) AddValues(elements ...interface{}) error {
+    if len(elements) > 0 && (l.Capacity() == 0 || (!l.overwrite && l.size + len(elements) > l.Capacity())) {
+        return collection.ErrorCapacityReached
+    }
+
+    for _, v := range elements {
+        _ = l.AddLast(v)
+    }
+
+    return nil
+}
+
+// AddWithIndex inserts the provided element into the CircularList specified by index. The position of the elements
+// that were at positions index to CircularList.Size() - 1 increase by one. The returned error will be non-nil if the
+// provided index is outside the current bounds of the CircularList (index < 0 || index > CircularList.Size()), or
+// collection.ErrorCapacityReached if the CircularList has reached capacity regardless of whether it overwrites.
+func (l *circularList) AddWithIndex(index int, element interface{}) error {
+    if index < 0 || index > l.Size() {
+        return l.outOfBounds(index)
+    }
+
+    if l.IsFull() {
+        return collection.ErrorCapacityReached
+    }
+
+    l.insertAt(index, element)
+
+    return nil
+}
+
+// ValueWithIndex returns the element at the position specified by the provided index. The returned error will be
+// non-nil if the provided index is outside the current bounds of the CircularList
+// (index < 0 || index > CircularList.Size() - 1).
+func (l *circularList) ValueWithIndex(index int) (interface{}, error) {
+    if err := l.checkBounds(index); err != nil {
+        return nil, err
+    }
+
+    return l.elements[l.position(index)], nil
+}
+
+// IndexOf returns the position of the first occurrence (if any) of an element equivalent to the provided element. The
+// returned error will be non-nil if provided element is not found in the CircularList, and the returned index will be
+// equal to collection.ElementNotFound.
+func (l *circularList) IndexOf(element interface{}) (int, error) {
+    for i := 0; i < l.size; i++ {
+        if reflect.DeepEqual(l.elements[l.position(i)], element) {
+            return i, nil
+        }
+    }
+
+    return collection.ElementNotFound, collection.ErrorElementNotFound
+}
+
+// Remove removes the first occurrence (if any) of an element equivalent to the provided element. If an element was
+// removed, the return value will be true, otherwise false will be returned.
+func (l *circularList) Remove(element interface{}) bool {
+    if i, err := l.IndexOf(element); err == nil {
+        l.removeAt(i)
+
+        return true
+    }
+
+    return false
+}
+
+// RemoveAll removes every occurrence of each element of the provided collection from the CircularList, and returns the
+// number of elements removed. A nil collection removes no elements.
+func (l *circularList) RemoveAll(other collection.Collection) int {
+    return collection.RemoveAll(l, other)
+}
+
+// RetainAll removes every element from the CircularList that does not exist in the provided collection, and returns the
+// number of elements removed. A nil collection is treated as an empty collection.
+func (l *circularList) RetainAll(other collection.Collection) int {
+    return collection.RetainAll(l, other)
+}
+
+// RemoveFirst removes the element at the front (index == 0) of the CircularList and returns it. If the CircularList is
+// empty (CircularList.Size() == 0), the return value will be nil.
+func (l *circularList) RemoveFirst() interface{} {
+    if l.Size() > 0 {
+        return l.removeAt(0)
+    }
+
+    return nil
+}
+
+// RemoveLast removes the element at the end (index == CircularList.Size() - 1) of the CircularList and returns it. If
+// the CircularList is empty (CircularList.Size() == 0), the return value will be nil.
+func (l *circularList) RemoveLast() interface{} {
+    if l.Size() > 0 {
+        return l.removeAt(l.size - 1)
+    }
+
+    return nil
+}
+
+// RemoveWithIndex removes the element at the provided index from the CircularList and returns it. The positions of the
+// elements originally at positions index + 1 to CircularList.Size() - 1 are decremented by 1. The returned error will
+// be non-nil if the provided index is outside the bounds of the CircularList
+// (index < 0 || index > CircularList.Size() - 1).
+func (l *circularList) RemoveWithIndex(index int) (interface{}, error) {
+    if err := l.checkBounds(index); err != nil {
+        return nil, err
+    }
+
+    return l.removeAt(index), nil
+}
+
+// RemoveFirstMatch removes the first element of the CircularList that matches the given predicate and returns it.
+func (l *circularList) RemoveFirstMatch(predicate func(element interface{}) bool) (interface{}, bool) {
+    for i := 0; i < l.size; i++ {
+        if element := l.elements[l.position(i)]; predicate(element) {
+            _, _ = l.RemoveWithIndex(i)
+
+            return element, true
+        }
+    }
+
+    return nil, false
+}
+
+// RemoveRange removes the elements of the CircularList at positions from (inclusive) to to (exclusive) by shifting the
+// elements that follow them once. An empty range (from == to) removes no elements. The returned error will be non-nil
+// if the provided range is outside the current bounds of the CircularList
+// (from < 0 || from > to || to > CircularList.Size()).
+func (l *circularList) RemoveRange(from int, to int) error {
+    if err := l.checkRange(from, to); err != nil {
+        return err
+    }
+
+    if from == 0 {
+        for i := 0; i < to; i++ {
+            l.elements[l.position(i)] = nil
+        }
+        if to > 0 {
+            l.front = l.position(to)
+        }
+    } else {
+        for i := to; i < l.size; i++ {
+            l.elements[l.position(i - (to - from))] = l.elements[l.position(i)]
+        }
+        for i := l.size - (to - from); i < l.size; i++ {
+            l.elements[l.position(i)] = nil
+        }
+    }
+
+    l.size -= to - from
+
+    return nil
+}
+
+// Splice removes the elements of the CircularList at positions from (inclusive) to to (exclusive), and returns them as a
+// new CircularList with the same capacity. The returned error will be non-nil if the provided range is outside the
+// current bounds of the CircularList (from < 0 || from > to || to > CircularList.Size()).
+func (l *circularList) Splice(from int, to int) (List, error) {
+    if err := l.checkRange(from, to); err != nil {
+        return nil, err
+    }
+
+    list := newCircularList(l.Capacity(), l.overwrite)
+    for i := from; i < to; i++ {
+        _ = list.Add(l.elements[l.position(i)])
+    }
+    _ = l.RemoveRange(from, to)
+
+    return list, nil
+}
+
+// Compact removes every nil element from the CircularList, and returns the number of elements removed. The remaining
+// elements are shifted towards the front of the CircularList in a single pass.
+func (l *circularList) Compact() int {
+    size := 0
+    for i := 0; i < l.size; i++ {
+        if v := l.elements[l.position(i)]; v != nil {
+            l.elements[l.position(size)] = v
+            size++
+        }
+    }
+
+    removed := l.size - size
+    for i := size; i < l.size; i++ {
+        l.elements[l.position(i)] = nil
+    }
+    l.size = size
+
+    return removed
+}
+
+// InsertSorted inserts the provided element into the CircularList after any elements that are not greater than it
+// according to the provided comparator, and returns the position where the element was placed. The insertion position
+// is found with a binary search, so the CircularList is assumed to already be sorted by the comparator. The returned
+// position will be collection.ElementNotFound if the CircularList has reached capacity, regardless of whether it
+// overwrites, since there is no oldest element that could be discarded without disturbing the order.
+func (l *circularList) InsertSorted(element interface{}, less func(a, b interface{}) bool) int {
+    if l.IsFull() {
+        return collection.ElementNotFound
+    }
+
+    index := sort.Search(l.size, func(i int) bool { return less(element, l.elements[l.position(i)]) })
+    l.insertAt(index, element)
+
+    return index
+}
+
+// Fill replaces every element of the CircularList with the provided value. The size of the CircularList is unchanged.
+func (l *circularList) Fill(value interface{}) {
+    _ = l.FillRange(0, l.size, value)
+}
+
+// FillRange replaces the elements of the CircularList at positions from (inclusive) to to (exclusive) with the provided
+// value. The size of the CircularList is unchanged. The returned error will be non-nil if the provided range is outside
+// the current bounds of the CircularList (from < 0 || from > to || to > CircularList.Size()).
+func (l *circularList) FillRange(from int, to int, value interface{}) error {
+    if err := l.checkRange(from, to); err != nil {
+        return err
+    }
+
+    for i := from; i < to; i++ {
+        l.elements[l.position(i)] = value
+    }
+
+    return nil
+}
+
+// Apply replaces each element of the CircularList with the result of applying the provided function to it. Unlike Map,
+// the elements are overwritten in the ring buffer of the CircularList.
+func (l *circularList) Apply(mapper func(element interface{}) interface{}) {
+    for i := 0; i < l.size; i++ {
+        position := l.position(i)
+        l.elements[position] = mapper(l.elements[position])
+    }
+}
+
+// Filter returns a new CircularList with the same capacity consisting of the elements of this CircularList that match
+// the given predicate.
+func (l *circularList) Filter(predicate func(element interface{}) bool) List {
+    list := newCircularList(l.Capacity(), l.overwrite)
+
+    l.ForEach(func(element interface{}) {
+        if predicate(element) {
+            _ = list.Add(element)
+        }
+    })
+
+    return list
+}
+
+// FilterIndexed returns a new CircularList with the same capacity consisting of the elements of this CircularList that
+// match the given predicate, which is provided the position of each element in this CircularList along with the
+// element.
+func (l *circularList) FilterIndexed(predicate func(index int, element interface{}) bool) List {
+    index := -1
+
+    return l.Filter(func(element interface{}) bool {
+        index++
+        return predicate(index, element)
+    })
+}
+
+// Find returns the first element of the CircularList that matches the given predicate, and true if such an element
+// exists.
+func (l *circularList) Find(predicate func(element interface{}) bool) (interface{}, bool) {
+    for i := 0; i < l.size; i++ {
+        if element := l.elements[l.position(i)]; predicate(element) {
+            return element, true
+        }
+    }
+
+    return nil, false
+}
+
+// Any returns true if at least one element of the CircularList matches the given predicate, otherwise false is
+// returned.
+func (l *circularList) Any(predicate func(element interface{}) bool) bool {
+    _, found := l.Find(predicate)
+
+    return found
+}
+
+// All returns true if every element of the CircularList matches the given predicate, otherwise false is returned.
+func (l *circularList) All(predicate func(element interface{}) bool) bool {
+    _, found := l.Find(func(element interface{}) bool { return !predicate(element) })
+
+    return !found
+}
+
+// Map returns a new CircularList with the same capacity containing the resulting elements of applying the given
+// function to the elements of this CircularList.
+func (l *circularList) Map(mapper func(element interface{}) interface{}) List {
+    list := newCircularList(l.Capacity(), l.overwrite)
+
+    l.ForEach(func(element interface{}) { _ = list.Add(mapper(element)) })
+
+    return list
+}
+
+// MapError returns a new CircularList with the same capacity containing the resulting elements of applying the given
+// function to the elements of this CircularList. If the function returns an error, mapping stops and the error is
+// returned along with the CircularList of the elements mapped before the failure.
+func (l *circularList) MapError(mapper func(element interface{}) (interface{}, error)) (List, error) {
+    list := newCircularList(l.Capacity(), l.overwrite)
+
+    for i := 0; i < l.size; i++ {
+        element, err := mapper(l.elements[l.position(i)])
+        if err != nil {
+            return list, err
+        }
+        _ = list.Add(element)
+    }
+
+    return list, nil
+}
+
+// ForEach performs the provided consumer function for each element of the CircularList.
+func (l *circularList) ForEach(consumer func(element interface{})) {
+    for i := 0; i < l.size; i++ {
+        consumer(l.elements[l.position(i)])
+    }
+}
+
+// Stream returns a channel over which the elements of the CircularList are sent in iteration order, which is closed
+// once every element has been sent. The CircularList must not be modified until the channel is closed.
+func (l *circularList) Stream() <-chan interface{} {
+    return collection.Stream(l, nil)
+}
+
+// ToSlice copies the elements of the CircularList into the slice pointed to by the provided destination (e.g.
+// *[]string), replacing its contents. The returned error will be non-nil if the destination is not a non-nil pointer to
+// a slice, or if any element is not assignable to the element type of the slice.
+func (l *circularList) ToSlice(dst interface{}) error {
+    return toSlice(l.Values(), dst)
+}
+
+// CopyTo copies as many elements of the CircularList as fit into the provided slice, and returns the number copied.
+func (l *circularList) CopyTo(dst []interface{}) int {
+    n := 0
+    for ; n < len(dst) && n < l.size; n++ {
+        dst[n] = l.elements[l.position(n)]
+    }
+
+    return n
+}
+
+// Size returns the number of elements in the CircularList.
+func (l *circularList) Size() int {
+    return l.size
+}
+
+// IsEmpty returns true if the CircularList contains no elements, otherwise false is returned.
+func (l *circularList) IsEmpty() bool {
+    return l.Size() == 0
+}
+
+// Capacity returns the maximum number of elements the CircularList can hold.
+func (l *circularList) Capacity() int {
+    return len(l.elements)
+}
+
+// IsFull returns true if the CircularList holds as many elements as its capacity, otherwise false is returned. A full
+// CircularList that overwrites still accepts further elements by discarding others.
+func (l *circularList) IsFull() bool {
+    return l.size == l.Capacity()
+}
+
+// Clear removes all elements from the CircularList.
+func (l *circularList) Clear() {
+    for i := range l.elements {
+        l.elements[i] = nil
+    }
+
+    l.front = 0
+    l.size  = 0
+}
+
+// Contains returns true if an element equivalent to the provided element exists in the CircularList, otherwise false
+// is returned.
+func (l *circularList) Contains(element interface{}) bool {
+    _, err := l.IndexOf(element)
+
+    return err == nil
+}
+
+// ContainsAll returns true if every element of the provided collection exists in the CircularList, otherwise false is
+// returned. A nil or empty collection is contained by every CircularList.
+func (l *circularList) ContainsAll(other collection.Collection) bool {
+    return collection.ContainsAll(l, other)
+}
+
+// ContainsAllOf returns a map from each of the provided elements to true if it exists in the CircularList, otherwise
+// false. Elements that are not hashable cannot be keys of the map, and are omitted.
+func (l *circularList) ContainsAllOf(elements []interface{}) map[interface{}]bool {
+    return collection.ContainsAllOf(l, elements)
+}
+
+// EqualsIgnoreOrder returns true if the provided List contains the same elements as the CircularList with the same
+// number of occurrences, regardless of their positions. A nil List is not equal to any List.
+func (l *circularList) EqualsIgnoreOrder(other List) bool {
+    return other != nil && collection.Equals(l, other)
+}
+
+// Values returns a slice containing the elements in the CircularList in the iteration order.
+func (l *circularList) Values() []interface{} {
+    elements := make([]interface{}, 0, l.Size())
+    l.ForEach(func(element interface{}) {
+        elements = append(elements, element)
+    })
+
+    return elements
+}
+
+// Clone returns a new CircularList with the same capacity and overwrite behavior containing the elements of the
+// CircularList. The elements themselves are not copied.
+func (l *circularList) Clone() collection.Collection {
+    clone := newCircularList(len(l.elements), l.overwrite)
+    clone.size = copy(clone.elements, l.Values())
+
+    return clone
+}
+
+// Iterator returns an Iterator positioned before the first element of the CircularList.
+func (l *circularList) Iterator() collection.Iterator {
+    return newIndexIterator(l)
+}
+
+// ReverseIterator returns an Iterator positioned after the last element of the CircularList, which traverses the
+// elements from the last to the first.
+func (l *circularList) ReverseIterator() collection.Iterator {
+    return newReverseIndexIterator(l)
+}
+
+// String returns a string representation of the CircularList in it's current state.
+func (l *circularList) String() string {
+    return collection.Format(l)
+}
+
+// StringQuoted returns a string representation of the CircularList in which string elements are quoted and nil elements are
+// rendered as nil.
+func (l *circularList) StringQuoted() string {
+    return stringQuoted(l.Values())
+}
+
+func (l *circularList) checkBounds(index int) error {
+    if index < 0 || index >= l.Size() {
+        return l.outOfBounds(index)
+    }
+
+    return nil
+}
+
+func (l *circularList) checkRange(from int, to int) error {
+    if from < 0 || from > to || to > l.Size() {
+        return errors.Errorf("range out of bounds [*CircularList.Size() = %v, requested range = [%v, %v)]", l.Size(), from, to)
+    }
+
+    return nil
+}
+
+func (l *circularList) outOfBounds(index int) error {
+    return errors.Errorf("index out of bounds [*CircularList.Size() = %v, requested index = %v]", l.Size(), index)
+}
+
+// position returns the index within the ring buffer of the element offset from the front of the CircularList.
+func (l *circularList) position(offset int) int {
+    n := l.Capacity()
+
+    return ((l.front + offset) % n + n) % n
+}
+
+// insertAt inserts the provided element at the provided index, shifting the elements after it towards the end of the
+// CircularList. The CircularList is assumed to have room for the element.
+func (l *circularList) insertAt(index int, element interface{}) {
+    if index == 0 {
+        l.front = l.position(-1)
+    } else {
+        for i := l.size; i > index; i-- {
+            l.elements[l.position(i)] = l.elements[l.position(i - 1)]
+        }
+    }
+
+    l.elements[l.position(index)] = element
+    l.size++
+}
+
+// removeAt removes and returns the element at the provided index, shifting the elements after it towards the front of
+// the CircularList. The index is assumed to be within bounds.
+func (l *circularList) removeAt(index int) interface{} {
+    element := l.elements[l.position(index)]
+
+    if index == 0 {
+        l.elements[l.front] = nil
+        l.front = l.position(1)
+    } else {
+        for i := index; i < l.size - 1; i++ {
+            l.elements[l.position(i)] = l.elements[l.position(i + 1)]
+        }
+        l.elements[l.position(l.size - 1)] = nil
+    }
+
+    l.size--
+
+    return element
+}
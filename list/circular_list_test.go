@@ -0,0 +1,235 @@
+package list
+
+import (
+    "fmt"
+    "testing"
+
+    "github.com/2speed/go-collection"
+)
+
+func TestCircularList_Capacity(t *testing.T) {
+    t.Run("Error", func(t *testing.T) {
+        list := NewCircularList(3)
+
+        assertError(t, list.Add("samus"), nil)
+        assertError(t, list.Add("yoshi"), nil)
+        assertError(t, list.AddFirst("luffy"), nil)
+        assertError(t, list.Add("chopper"), collection.ErrorCapacityReached)
+        assertError(t, list.AddFirst("chopper"), collection.ErrorCapacityReached)
+        assertError(t, list.AddWithIndex(1, "chopper"), collection.ErrorCapacityReached)
+        assertSize(t, list, 3)
+        assertContentEquals(t, list, "[luffy, samus, yoshi]")
+    })
+
+    t.Run("Overwrite", func(t *testing.T) {
+        list := NewCircularListWithOverwrite(3)
+
+        for _, v := range []interface{}{ 1, 2, 3, 4, 5 } {
+            assertError(t, list.Add(v), nil)
+        }
+        assertSize(t, list, 3)
+        assertContentEquals(t, list, "[3, 4, 5]")
+
+        assertError(t, list.AddFirst(0), nil)
+        assertContentEquals(t, list, "[0, 3, 4]")
+    })
+
+    t.Run("AddAll", func(t *testing.T) {
+        list := NewCircularList(2)
+        err  := list.AddAll(NewArrayListOf([]interface{}{ "a", "b", "c" }))
+
+        assertError(t, err, collection.ErrorCapacityReached)
+        assertContentEquals(t, list, "[]")
+
+        _ = list.Add("a")
+        err = list.AddAll(NewArrayListOf([]interface{}{ "b", "c" }))
+
+        assertError(t, err, collection.ErrorCapacityReached)
+        assertContentEquals(t, list, "[a]")
+
+        err = list.AddAll(NewArrayListOf([]interface{}{ "b" }))
+
+        assertError(t, err, nil)
+        assertContentEquals(t, list, "[a, b]")
+    })
+
+    t.Run("AddValues", func(t *testing.T) {
+        list := NewCircularList(3)
+
+        assertError(t, list.AddValues("a", "b", "c", "d"), collection.ErrorCapacityReached)
+        assertContentEquals(t, list, "[]")
+
+        assertError(t, list.AddValues("a", "b"), nil)
+        assertError(t, list.AddValues("c", "d"), collection.ErrorCapacityReached)
+        assertContentEquals(t, list, "[a, b]")
+
+        list = NewCircularListWithOverwrite(3)
+
+        assertError(t, list.AddValues("a", "b", "c", "d"), nil)
+        assertContentEquals(t, list, "[b, c, d]")
+    })
+
+    t.Run("Zero", func(t *testing.T) {
+        list := NewCircularListWithOverwrite(0)
+
+        assertError(t, list.Add("a"), collection.ErrorCapacityReached)
+        assertSize(t, list, 0)
+    })
+}
+
+func TestCircularList_FIFO(t *testing.T) {
+    list := NewCircularList(4)
+
+    for round := 0; round < 3; round++ {
+        for i := 0; i < 4; i++ {
+            assertError(t, list.Add(round * 10 + i), nil)
+        }
+
+        for i := 0; i < 4; i++ {
+            if actual := list.RemoveFirst(); actual != round * 10 + i {
+                t.Errorf("expected value '%d', but found '%v'", round * 10 + i, actual)
+            }
+        }
+    }
+
+    if list.RemoveFirst() != nil {
+        t.Error("expected nil from RemoveFirst on empty list")
+    }
+}
+
+func TestCircularList_Positional(t *testing.T) {
+    list := NewCircularList(6)
+    _ = list.AddAll(NewArrayListOf([]interface{}{ "b", "d" }))
+    _ = list.AddFirst("a")
+
+    assertError(t, list.AddWithIndex(2, "c"), nil)
+    assertError(t, list.AddWithIndex(4, "e"), nil)
+    assertContentEquals(t, list, "[a, b, c, d, e]")
+
+    v, err := list.ValueWithIndex(3)
+    assertError(t, err, nil)
+    if v != "d" {
+        t.Errorf("expected value 'd', but found '%v'", v)
+    }
+
+    v, err = list.RemoveWithIndex(2)
+    assertError(t, err, nil)
+    if v != "c" {
+        t.Errorf("expected value 'c', but found '%v'", v)
+    }
+
+    if !list.Remove("a") {
+        t.Error("expected result to be true")
+    }
+
+    if list.RemoveLast() != "e" {
+        t.Error("expected RemoveLast to return 'e'")
+    }
+    assertContentEquals(t, list, "[b, d]")
+
+    if _, err := list.ValueWithIndex(2); err == nil {
+        t.Error("expected error for index 2")
+    }
+
+    list.Clear()
+    assertSize(t, list, 0)
+}
+
+func TestCircularList_Bounded(t *testing.T) {
+    list := NewCircularList(2)
+
+    bounded, ok := list.(collection.Bounded)
+    if !ok {
+        t.Fatal("expected CircularList to implement collection.Bounded")
+    }
+
+    if bounded.Capacity() != 2 || bounded.IsFull() {
+        t.Errorf("expected capacity 2 and not full, but found capacity %d and full %t", bounded.Capacity(), bounded.IsFull())
+    }
+
+    assertError(t, list.Add("a"), nil)
+    if bounded.IsFull() {
+        t.Error("expected not to be full")
+    }
+
+    assertError(t, list.Add("b"), nil)
+    if !bounded.IsFull() || !collection.IsFull(list) {
+        t.Error("expected to be full")
+    }
+    assertError(t, list.Add("c"), collection.ErrorCapacityReached)
+
+    _ = list.RemoveFirst()
+    if bounded.IsFull() {
+        t.Error("expected not to be full after removal")
+    }
+
+    synchronized := NewSynchronizedList(list)
+    if actual := collection.Capacity(synchronized); actual != 2 {
+        t.Errorf("expected synchronized capacity 2, but found %d", actual)
+    }
+
+    if actual := collection.Capacity(NewArrayList()); actual != collection.Unbounded || collection.IsFull(NewArrayList()) {
+        t.Errorf("expected ArrayList to be unbounded, but found capacity %d", actual)
+    }
+}
+
+func TestCircularList_FilterIndexed(t *testing.T) {
+    list := NewCircularListWithOverwrite(4)
+    _ = list.AddAll(NewArrayListOf([]interface{}{ 1, 2, 3, 4, 5, 6 }))
+
+    filtered := list.FilterIndexed(func(index int, element interface{}) bool { return index % 2 == 1 })
+
+    assertContentEquals(t, filtered, "[4, 6]")
+    if actual := collection.Capacity(filtered); actual != 4 {
+        t.Errorf("expected capacity 4, but found %d", actual)
+    }
+}
+
+func TestCircularList_RemoveRange(t *testing.T) {
+    list := NewCircularListWithOverwrite(5)
+    _ = list.AddAll(NewArrayListOf([]interface{}{ "a", "b", "c", "d", "e", "f", "g" }))
+
+    assertContentEquals(t, list, "[c, d, e, f, g]")
+    assertError(t, list.RemoveRange(1, 3), nil)
+    assertContentEquals(t, list, "[c, f, g]")
+
+    assertError(t, list.RemoveRange(0, 1), nil)
+    assertContentEquals(t, list, "[f, g]")
+
+    _ = list.AddAll(NewArrayListOf([]interface{}{ "h", "i", "j" }))
+    assertContentEquals(t, list, "[f, g, h, i, j]")
+
+    if err := list.RemoveRange(2, 6); err == nil {
+        t.Error("expected error for range [2, 6)")
+    }
+
+    assertError(t, list.RemoveRange(3, 5), nil)
+    assertContentEquals(t, list, "[f, g, h]")
+    assertSize(t, list, 3)
+}
+
+func TestCircularList_InsertSorted(t *testing.T) {
+    less := func(a, b interface{}) bool { return a.(string) < b.(string) }
+    list := NewCircularListWithOverwrite(4)
+    _ = list.AddAll(NewArrayListOf([]interface{}{ "a", "a", "c", "e" }))
+    _ = list.RemoveFirst()
+
+    if index := list.InsertSorted("d", less); index != 2 {
+        t.Errorf("expected index 2, got %d", index)
+    }
+    assertContentEquals(t, list, "[a, c, d, e]")
+
+    if index := list.InsertSorted("b", less); index != collection.ElementNotFound {
+        t.Errorf("expected index %d, got %d", collection.ElementNotFound, index)
+    }
+    assertContentEquals(t, list, "[a, c, d, e]")
+}
+
+func assertContentEquals(t *testing.T, collection collection.Collection, expected string) {
+    t.Helper()
+
+    actual := fmt.Sprintf("%s", collection)
+    if actual != expected {
+        t.Errorf("expected content of '%s', but found '%s'", expected, actual)
+    }
+}
@@ -0,0 +1,425 @@
+package list
+
+import (
+    "sync"
+    "sync/atomic"
+
+    "github.com/2speed/go-collection"
+)
+
+// CopyOnWriteList is a List for read-heavy concurrent access whose mutators copy the backing slice before modifying it,
+// and then atomically replace the backing slice with the modified copy. Accessors read the backing slice without
+// locking, so they always observe a consistent snapshot of the elements, and are never blocked by mutators. Mutators are
+// serialized with a sync.Mutex, and each costs O(n) to copy the backing slice regardless of the modification. ForEach
+// and Iterator traverse the snapshot taken when they are called, so they are unaffected by concurrent modifications,
+// and the provided consumer function of ForEach may modify the CopyOnWriteList. The Lists returned by Filter and Map
+// are also CopyOnWriteLists.
+type copyOnWriteList struct {
+    mutex    sync.Mutex
+    elements atomic.Value
+}
+
+// NewCopyOnWriteList creates a new CopyOnWriteList.
+func NewCopyOnWriteList() List {
+    l := &copyOnWriteList{}
+    l.elements.Store(make([]interface{}, 0))
+
+    return l
+}
+
+// newCopyOnWriteListOf creates a new CopyOnWriteList whose backing slice is the backing slice of the provided
+// ArrayList, which must not be modified afterwards.
+func newCopyOnWriteListOf(list List) List {
+    l := &copyOnWriteList{}
+    l.elements.Store(list.(*arrayList).elements)
+
+    return l
+}
+
+// Add inserts the provided element into the CopyOnWriteList.
+func (l *copyOnWriteList) Add(element interface{}) error {
+    return l.write(func(list *arrayList) error { return list.Add(element) })
+}
+
+// AddAll inserts all elements from the provided Collection into the CopyOnWriteList. The elements of the provided
+// Collection are read before the CopyOnWriteList is locked, so a CopyOnWriteList may be added to itself.
+func (l *copyOnWriteList) AddAll(c collection.Collection) error {
+    if c == nil {
+        return nil
+    }
+
+    elements := NewArrayListOf(c.Values())
+
+    return l.write(func(list *arrayList) error { return list.AddAll(elements) })
+}
+
+// AddFirst inserts the provided element at the front (index == 0) of the CopyOnWriteList.
+func (l *copyOnWriteList) AddFirst(element interface{}) error {
+    return l.write(func(list *arrayList) error { return list.AddFirst(element) })
+}
+
+// AddLast inserts the provided element at the end of the CopyOnWriteList (index == CopyOnWriteList.Size()).
+func (l *copyOnWriteList) AddLast(element interface{}) error {
+    return l.write(func(list *arrayList) error { return list.AddLast(element) })
+}
+
+// AddValues inserts the provided elements at the end of the CopyOnWriteList in the order they are provided.
+func (l *copyOnWriteList) AddValues(elements ...interface{}) error {
+    return l.write(func(list *arrayList) error { return list.AddValues(elements...) })
+}
+
+// AddWithIndex inserts the provided element into the CopyOnWriteList specified by index. The returned error will be
+// non-nil if the provided index is outside the current bounds of the CopyOnWriteList
+// (index < 0 || index > CopyOnWriteList.Size()).
+func (l *copyOnWriteList) AddWithIndex(index int, element interface{}) error {
+    return l.write(func(list *arrayList) error { return list.AddWithIndex(index, element) })
+}
+
+// ValueWithIndex returns the element at the position specified by the provided index.
+func (l *copyOnWriteList) ValueWithIndex(index int) (interface{}, error) {
+    return l.snapshot().ValueWithIndex(index)
+}
+
+// IndexOf returns the position of the first occurrence (if any) of an element equivalent to the provided element.
+func (l *copyOnWriteList) IndexOf(element interface{}) (int, error) {
+    return l.snapshot().IndexOf(element)
+}
+
+// Remove removes the first occurrence (if any) of an element equivalent to the provided element.
+func (l *copyOnWriteList) Remove(element interface{}) bool {
+    removed := false
+    _ = l.write(func(list *arrayList) error {
+        removed = list.Remove(element)
+        return nil
+    })
+
+    return removed
+}
+
+// RemoveAll removes every occurrence of each element of the provided collection from the CopyOnWriteList, and returns
+// the number of elements removed. A nil collection removes no elements.
+func (l *copyOnWriteList) RemoveAll(other collection.Collection) int {
+    if other == nil {
+        return 0
+    }
+
+    elements := NewArrayListOf(other.Values())
+
+    count := 0
+    _ = l.write(func(list *arrayList) error {
+        count = list.RemoveAll(elements)
+        return nil
+    })
+
+    return count
+}
+
+// RetainAll removes every element from the CopyOnWriteList that does not exist in the provided collection, and returns
+// the number of elements removed. A nil collection is treated as an empty collection.
+func (l *copyOnWriteList) RetainAll(other collection.Collection) int {
+    elements := NewArrayList()
+    if other != nil {
+        elements = NewArrayListOf(other.Values())
+    }
+
+    count := 0
+    _ = l.write(func(list *arrayList) error {
+        count = list.RetainAll(elements)
+        return nil
+    })
+
+    return count
+}
+
+// RemoveFirst removes the element at the front (index == 0) of the CopyOnWriteList and returns it.
+func (l *copyOnWriteList) RemoveFirst() interface{} {
+    var element interface{}
+    _ = l.write(func(list *arrayList) error {
+        element = list.RemoveFirst()
+        return nil
+    })
+
+    return element
+}
+
+// RemoveLast removes the element at the end (index == CopyOnWriteList.Size() - 1) of the CopyOnWriteList and returns
+// it.
+func (l *copyOnWriteList) RemoveLast() interface{} {
+    var element interface{}
+    _ = l.write(func(list *arrayList) error {
+        element = list.RemoveLast()
+        return nil
+    })
+
+    return element
+}
+
+// RemoveWithIndex removes the element at the provided index from the CopyOnWriteList and returns it.
+func (l *copyOnWriteList) RemoveWithIndex(index int) (interface{}, error) {
+    var element interface{}
+    err := l.write(func(list *arrayList) error {
+        var err error
+        element, err = list.RemoveWithIndex(index)
+        return err
+    })
+
+    return element, err
+}
+
+// RemoveFirstMatch removes the first element of the CopyOnWriteList that matches the given predicate and returns it. If
+// no element matches the predicate, the backing slice is not replaced.
+func (l *copyOnWriteList) RemoveFirstMatch(predicate func(element interface{}) bool) (interface{}, bool) {
+    var element interface{}
+    err := l.write(func(list *arrayList) error {
+        var found bool
+        if element, found = list.RemoveFirstMatch(predicate); !found {
+            return collection.ErrorElementNotFound
+        }
+        return nil
+    })
+
+    return element, err == nil
+}
+
+// RemoveRange removes the elements of the CopyOnWriteList at positions from (inclusive) to to (exclusive). The returned
+// error will be non-nil if the provided range is outside the current bounds of the CopyOnWriteList
+// (from < 0 || from > to || to > CopyOnWriteList.Size()).
+func (l *copyOnWriteList) RemoveRange(from int, to int) error {
+    return l.write(func(list *arrayList) error { return list.RemoveRange(from, to) })
+}
+
+// Splice removes the elements of the CopyOnWriteList at positions from (inclusive) to to (exclusive), and returns them
+// as a new CopyOnWriteList. The returned error will be non-nil if the provided range is outside the current bounds of
+// the CopyOnWriteList (from < 0 || from > to || to > CopyOnWriteList.Size()).
+func (l *copyOnWriteList) Splice(from int, to int) (List, error) {
+    var spliced List
+    err := l.write(func(list *arrayList) (err error) {
+        spliced, err = list.Splice(from, to)
+        return err
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    return newCopyOnWriteListOf(spliced), nil
+}
+
+// Compact removes every nil element from the CopyOnWriteList, and returns the number of elements removed.
+func (l *copyOnWriteList) Compact() int {
+    removed := 0
+    _ = l.write(func(list *arrayList) error {
+        removed = list.Compact()
+        return nil
+    })
+
+    return removed
+}
+
+// InsertSorted inserts the provided element into the CopyOnWriteList after any elements that are not greater than it
+// according to the provided comparator, and returns the position where the element was placed. The CopyOnWriteList is
+// assumed to already be sorted by the comparator.
+func (l *copyOnWriteList) InsertSorted(element interface{}, less func(a, b interface{}) bool) int {
+    index := 0
+    _ = l.write(func(list *arrayList) error {
+        index = list.InsertSorted(element, less)
+        return nil
+    })
+
+    return index
+}
+
+// Fill replaces every element of the CopyOnWriteList with the provided value. The size of the CopyOnWriteList is
+// unchanged.
+func (l *copyOnWriteList) Fill(value interface{}) {
+    _ = l.write(func(list *arrayList) error {
+        list.Fill(value)
+        return nil
+    })
+}
+
+// FillRange replaces the elements of the CopyOnWriteList at positions from (inclusive) to to (exclusive) with the
+// provided value. The returned error will be non-nil if the provided range is outside the current bounds of the
+// CopyOnWriteList (from < 0 || from > to || to > CopyOnWriteList.Size()).
+func (l *copyOnWriteList) FillRange(from int, to int, value interface{}) error {
+    return l.write(func(list *arrayList) error { return list.FillRange(from, to, value) })
+}
+
+// Apply replaces each element of the CopyOnWriteList with the result of applying the provided function to it. As with
+// every mutator of the CopyOnWriteList, the function is applied to a copy of the backing slice, which then replaces it.
+func (l *copyOnWriteList) Apply(mapper func(element interface{}) interface{}) {
+    _ = l.write(func(list *arrayList) error {
+        list.Apply(mapper)
+        return nil
+    })
+}
+
+// Filter returns a new CopyOnWriteList consisting of the elements of this CopyOnWriteList that match the given
+// predicate.
+func (l *copyOnWriteList) Filter(predicate func(element interface{}) bool) List {
+    return newCopyOnWriteListOf(l.snapshot().Filter(predicate))
+}
+
+// FilterIndexed returns a new CopyOnWriteList consisting of the elements of this CopyOnWriteList that match the given
+// predicate, which is provided the position of each element in this CopyOnWriteList along with the element.
+func (l *copyOnWriteList) FilterIndexed(predicate func(index int, element interface{}) bool) List {
+    return newCopyOnWriteListOf(l.snapshot().FilterIndexed(predicate))
+}
+
+// Find returns the first element of the CopyOnWriteList that matches the given predicate, and true if such an element
+// exists. The elements are traversed in the snapshot of the CopyOnWriteList taken when Find is called.
+func (l *copyOnWriteList) Find(predicate func(element interface{}) bool) (interface{}, bool) {
+    return l.snapshot().Find(predicate)
+}
+
+// Any returns true if at least one element of the CopyOnWriteList matches the given predicate, otherwise false is
+// returned.
+func (l *copyOnWriteList) Any(predicate func(element interface{}) bool) bool {
+    return l.snapshot().Any(predicate)
+}
+
+// All returns true if every element of the CopyOnWriteList matches the given predicate, otherwise false is returned.
+func (l *copyOnWriteList) All(predicate func(element interface{}) bool) bool {
+    return l.snapshot().All(predicate)
+}
+
+// Map returns a new CopyOnWriteList containing the resulting elements of applying the given function to the elements of
+// this CopyOnWriteList.
+func (l *copyOnWriteList) Map(mapper func(element interface{}) interface{}) List {
+    return newCopyOnWriteListOf(l.snapshot().Map(mapper))
+}
+
+// MapError returns a new CopyOnWriteList containing the resulting elements of applying the given function to the
+// elements of this CopyOnWriteList. If the function returns an error, mapping stops and the error is returned along with
+// the CopyOnWriteList of the elements mapped before the failure.
+func (l *copyOnWriteList) MapError(mapper func(element interface{}) (interface{}, error)) (List, error) {
+    list, err := l.snapshot().MapError(mapper)
+
+    return newCopyOnWriteListOf(list), err
+}
+
+// ForEach performs the provided consumer function for each element of the snapshot of the CopyOnWriteList taken when
+// ForEach is called.
+func (l *copyOnWriteList) ForEach(consumer func(element interface{})) {
+    l.snapshot().ForEach(consumer)
+}
+
+// Stream returns a channel over which the elements of the CopyOnWriteList are sent in iteration order, which is closed
+// once every element has been sent. The elements are sent from the snapshot of the CopyOnWriteList taken when Stream is
+// called, so it may be modified before the channel is closed.
+func (l *copyOnWriteList) Stream() <-chan interface{} {
+    return collection.Stream(l.snapshot(), nil)
+}
+
+// ToSlice copies the elements of the CopyOnWriteList into the slice pointed to by the provided destination (e.g.
+// *[]string), replacing its contents. The returned error will be non-nil if the destination is not a non-nil pointer to
+// a slice, or if any element is not assignable to the element type of the slice.
+func (l *copyOnWriteList) ToSlice(dst interface{}) error {
+    return l.snapshot().ToSlice(dst)
+}
+
+// CopyTo copies as many elements of a snapshot of the CopyOnWriteList as fit into the provided slice, and returns the
+// number copied.
+func (l *copyOnWriteList) CopyTo(dst []interface{}) int {
+    return l.snapshot().CopyTo(dst)
+}
+
+// Size returns the number of elements in the CopyOnWriteList.
+func (l *copyOnWriteList) Size() int {
+    return l.snapshot().Size()
+}
+
+// IsEmpty returns true if the CopyOnWriteList contains no elements, otherwise false is returned.
+func (l *copyOnWriteList) IsEmpty() bool {
+    return l.snapshot().IsEmpty()
+}
+
+// Clear removes all elements from the CopyOnWriteList.
+func (l *copyOnWriteList) Clear() {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    l.elements.Store(make([]interface{}, 0))
+}
+
+// Contains returns true if an element equivalent to the provided element exists in the CopyOnWriteList, otherwise false
+// is returned.
+func (l *copyOnWriteList) Contains(element interface{}) bool {
+    return l.snapshot().Contains(element)
+}
+
+// ContainsAll returns true if every element of the provided collection exists in the CopyOnWriteList, otherwise false
+// is returned. A nil or empty collection is contained by every CopyOnWriteList.
+func (l *copyOnWriteList) ContainsAll(other collection.Collection) bool {
+    return l.snapshot().ContainsAll(other)
+}
+
+// ContainsAllOf returns a map from each of the provided elements to true if it exists in the CopyOnWriteList, otherwise
+// false. Elements that are not hashable cannot be keys of the map, and are omitted.
+func (l *copyOnWriteList) ContainsAllOf(elements []interface{}) map[interface{}]bool {
+    return l.snapshot().ContainsAllOf(elements)
+}
+
+// EqualsIgnoreOrder returns true if the provided List contains the same elements as the CopyOnWriteList with the same
+// number of occurrences, regardless of their positions. A nil List is not equal to any List.
+func (l *copyOnWriteList) EqualsIgnoreOrder(other List) bool {
+    return other != nil && collection.Equals(l, other)
+}
+
+// Values returns a slice containing the elements in the CopyOnWriteList in the iteration order.
+func (l *copyOnWriteList) Values() []interface{} {
+    return l.snapshot().Values()
+}
+
+// Clone returns a new CopyOnWriteList containing the elements of the CopyOnWriteList. Since the mutators of either List
+// replace the backing slice rather than modify it, the clone shares the current backing slice without copying it.
+func (l *copyOnWriteList) Clone() collection.Collection {
+    return newCopyOnWriteListOf(l.snapshot())
+}
+
+// Iterator returns an Iterator positioned before the first element of the CopyOnWriteList. The Iterator traverses the
+// snapshot of the CopyOnWriteList taken when the Iterator is created, so it is unaffected by concurrent modifications.
+func (l *copyOnWriteList) Iterator() collection.Iterator {
+    return newIndexIterator(l.snapshot())
+}
+
+// ReverseIterator returns an Iterator positioned after the last element of the CopyOnWriteList, which traverses the
+// snapshot of the CopyOnWriteList taken when the Iterator is created from the last element to the first.
+func (l *copyOnWriteList) ReverseIterator() collection.Iterator {
+    return newReverseIndexIterator(l.snapshot())
+}
+
+// String returns a string representation of the CopyOnWriteList in it's current state.
+func (l *copyOnWriteList) String() string {
+    return l.snapshot().String()
+}
+
+// StringQuoted returns a string representation of the CopyOnWriteList in which string elements are quoted and nil
+// elements are rendered as nil.
+func (l *copyOnWriteList) StringQuoted() string {
+    return l.snapshot().StringQuoted()
+}
+
+// snapshot returns an ArrayList backed by the current backing slice of the CopyOnWriteList. The returned ArrayList must
+// not be modified.
+func (l *copyOnWriteList) snapshot() *arrayList {
+    return &arrayList{ elements: l.elements.Load().([]interface{}) }
+}
+
+// write applies the provided mutator to an ArrayList backed by a copy of the current backing slice, and then replaces
+// the backing slice with the copy. If the mutator returns a non-nil error, the backing slice is left unchanged.
+func (l *copyOnWriteList) write(mutator func(list *arrayList) error) error {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    elements := l.elements.Load().([]interface{})
+
+    list := newArrayListWithCapacity(len(elements) + 1)
+    list.elements = append(list.elements, elements...)
+
+    if err := mutator(list); err != nil {
+        return err
+    }
+    l.elements.Store(list.elements)
+
+    return nil
+}
@@ -0,0 +1,109 @@
+package list
+
+import (
+    "sync"
+    "testing"
+)
+
+func TestCopyOnWriteList_Concurrent(t *testing.T) {
+    const numWriters, numAddsPerWriter = 8, 250
+
+    list := NewCopyOnWriteList()
+
+    var wg sync.WaitGroup
+    for w := 0; w < numWriters; w++ {
+        wg.Add(2)
+
+        go func(w int) {
+            defer wg.Done()
+
+            for i := 0; i < numAddsPerWriter; i++ {
+                _ = list.Add(w * numAddsPerWriter + i)
+            }
+        }(w)
+
+        go func() {
+            defer wg.Done()
+
+            for i := 0; i < numAddsPerWriter; i++ {
+                size, count := list.Size(), 0
+                list.ForEach(func(element interface{}) { count++ })
+
+                if count < size {
+                    t.Errorf("expected ForEach to visit at least %d elements, but visited %d", size, count)
+                }
+                _ = list.Contains(i)
+            }
+        }()
+    }
+    wg.Wait()
+
+    assertSize(t, list, numWriters * numAddsPerWriter)
+    for i := 0; i < numWriters * numAddsPerWriter; i++ {
+        assertContains(t, list, i, true)
+    }
+}
+
+func TestCopyOnWriteList_Snapshot(t *testing.T) {
+    list := NewCopyOnWriteList()
+    _ = list.AddAll(NewArrayListOf([]interface{}{ 1, 2, 3 }))
+
+    iterator := list.Iterator()
+    visited  := NewArrayList()
+    list.ForEach(func(element interface{}) {
+        _ = list.Add(element.(int) * 10)
+        _ = visited.Add(element)
+    })
+
+    assertContentEquals(t, visited, "[1, 2, 3]")
+    assertContentEquals(t, list, "[1, 2, 3, 10, 20, 30]")
+
+    list.Clear()
+    for i := 1; i <= 3; i++ {
+        if element := iterator.Next(); element != i {
+            t.Errorf("expected iterator element %d, but found '%v'", i, element)
+        }
+    }
+    if iterator.HasNext() {
+        t.Error("expected iterator to be exhausted")
+    }
+}
+
+func TestCopyOnWriteList_Positional(t *testing.T) {
+    list := NewCopyOnWriteList()
+    _ = list.AddAll(NewArrayListOf([]interface{}{ "b", "d" }))
+
+    assertError(t, list.AddFirst("a"), nil)
+    assertError(t, list.AddWithIndex(2, "c"), nil)
+    assertContentEquals(t, list, "[a, b, c, d]")
+
+    if err := list.AddWithIndex(9, "z"); err == nil {
+        t.Error("expected error for index 9")
+    }
+    assertContentEquals(t, list, "[a, b, c, d]")
+
+    for _, index := range []int{ -1, list.Size() } {
+        if _, err := list.ValueWithIndex(index); err == nil {
+            t.Errorf("expected ValueWithIndex error for index %d", index)
+        }
+        if _, err := list.RemoveWithIndex(index); err == nil {
+            t.Errorf("expected RemoveWithIndex error for index %d", index)
+        }
+    }
+    assertContentEquals(t, list, "[a, b, c, d]")
+
+    if element, err := list.RemoveWithIndex(1); err != nil || element != "b" {
+        t.Errorf("expected to remove 'b', but found '%v' (%v)", element, err)
+    }
+    assertError(t, list.AddAll(list), nil)
+    assertContentEquals(t, list, "[a, c, d, a, c, d]")
+
+    if count := list.RemoveAll(NewArrayListOf("a")); count != 2 {
+        t.Errorf("expected to remove 2 elements, but removed %d", count)
+    }
+    assertContentEquals(t, list, "[c, d, c, d]")
+
+    if _, ok := list.Filter(func(element interface{}) bool { return element == "c" }).(*copyOnWriteList); !ok {
+        t.Error("expected Filter to return a CopyOnWriteList")
+    }
+}
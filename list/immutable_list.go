@@ -0,0 +1,264 @@
+package list
+
+import (
+    "fmt"
+
+    "github.com/2speed/go-collection"
+)
+
+// ImmutableList is a read-only view of a snapshot of the elements from a Collection. Mutators that return an error
+// return collection.ErrorImmutable, while the remaining mutators (e.g. Remove, RemoveFirst, Clear) leave the
+// ImmutableList unchanged. Filter and Map are not considered mutators, and return new mutable Lists.
+type immutableList struct {
+    delegate List
+}
+
+// NewImmutableList creates a new ImmutableList containing the elements from the provided Collection. Changes made to
+// the provided Collection after the ImmutableList is created are not reflected by the ImmutableList.
+func NewImmutableList(collection collection.Collection) List {
+    return &immutableList{ delegate: NewArrayListFrom(collection) }
+}
+
+// Add returns collection.ErrorImmutable.
+func (l *immutableList) Add(element interface{}) error {
+    return collection.ErrorImmutable
+}
+
+// AddAll returns collection.ErrorImmutable.
+func (l *immutableList) AddAll(collection.Collection) error {
+    return collection.ErrorImmutable
+}
+
+// AddFirst returns collection.ErrorImmutable.
+func (l *immutableList) AddFirst(element interface{}) error {
+    return collection.ErrorImmutable
+}
+
+// AddLast returns collection.ErrorImmutable.
+func (l *immutableList) AddLast(element interface{}) error {
+    return collection.ErrorImmutable
+}
+
+// AddValues returns collection.ErrorImmutable.
+func (l *immutableList) AddValues(elements ...interface{}) error {
+    return collection.ErrorImmutable
+}
+
+// AddWithIndex returns collection.ErrorImmutable.
+func (l *immutableList) AddWithIndex(index int, element interface{}) error {
+    return collection.ErrorImmutable
+}
+
+// ValueWithIndex returns the element at the position specified by the provided index. The returned error will be
+// non-nil if the provided index is outside the current bounds of the ImmutableList
+// (index < 0 || index > ImmutableList.Size() - 1).
+func (l *immutableList) ValueWithIndex(index int) (interface{}, error) {
+    return l.delegate.ValueWithIndex(index)
+}
+
+// IndexOf returns the position of the first occurrence (if any) of an element equivalent to the provided element. The
+// returned error will be non-nil if provided element is not found in the ImmutableList, and the returned index will be
+// equal to collection.ElementNotFound.
+func (l *immutableList) IndexOf(element interface{}) (int, error) {
+    return l.delegate.IndexOf(element)
+}
+
+// Remove does not remove any elements, and always returns false.
+func (l *immutableList) Remove(element interface{}) bool {
+    return false
+}
+
+// RemoveAll removes no elements from the ImmutableList and returns 0, since the ImmutableList cannot be modified.
+func (l *immutableList) RemoveAll(other collection.Collection) int {
+    return 0
+}
+
+// RetainAll removes no elements from the ImmutableList and returns 0, since the ImmutableList cannot be modified.
+func (l *immutableList) RetainAll(other collection.Collection) int {
+    return 0
+}
+
+// RemoveFirst does not remove any elements, and always returns nil.
+func (l *immutableList) RemoveFirst() interface{} {
+    return nil
+}
+
+// RemoveLast does not remove any elements, and always returns nil.
+func (l *immutableList) RemoveLast() interface{} {
+    return nil
+}
+
+// RemoveWithIndex does not remove any elements, and always returns collection.ErrorImmutable.
+func (l *immutableList) RemoveWithIndex(index int) (interface{}, error) {
+    return nil, collection.ErrorImmutable
+}
+
+// RemoveFirstMatch does not remove any elements from the ImmutableList, and returns nil and false.
+func (l *immutableList) RemoveFirstMatch(predicate func(element interface{}) bool) (interface{}, bool) {
+    return nil, false
+}
+
+// RemoveRange does not remove any elements, and always returns collection.ErrorImmutable.
+func (l *immutableList) RemoveRange(from int, to int) error {
+    return collection.ErrorImmutable
+}
+
+// Splice does not remove any elements, and always returns collection.ErrorImmutable.
+func (l *immutableList) Splice(from int, to int) (List, error) {
+    return nil, collection.ErrorImmutable
+}
+
+// Compact does not remove any elements, and always returns 0.
+func (l *immutableList) Compact() int {
+    return 0
+}
+
+// InsertSorted does not insert the provided element, and always returns collection.ElementNotFound.
+func (l *immutableList) InsertSorted(element interface{}, less func(a, b interface{}) bool) int {
+    return collection.ElementNotFound
+}
+
+// Fill does not replace any elements of the ImmutableList.
+func (l *immutableList) Fill(value interface{}) {}
+
+// FillRange returns collection.ErrorImmutable.
+func (l *immutableList) FillRange(from int, to int, value interface{}) error {
+    return collection.ErrorImmutable
+}
+
+// Apply does not replace any elements of the ImmutableList.
+func (l *immutableList) Apply(mapper func(element interface{}) interface{}) {}
+
+// Filter returns a new mutable List consisting of the elements of this ImmutableList that match the given predicate.
+func (l *immutableList) Filter(predicate func(element interface{}) bool) List {
+    return l.delegate.Filter(predicate)
+}
+
+// FilterIndexed returns a new mutable List consisting of the elements of this ImmutableList that match the given
+// predicate, which is provided the position of each element in this ImmutableList along with the element.
+func (l *immutableList) FilterIndexed(predicate func(index int, element interface{}) bool) List {
+    return l.delegate.FilterIndexed(predicate)
+}
+
+// Find returns the first element of the ImmutableList that matches the given predicate, and true if such an element
+// exists.
+func (l *immutableList) Find(predicate func(element interface{}) bool) (interface{}, bool) {
+    return l.delegate.Find(predicate)
+}
+
+// Any returns true if at least one element of the ImmutableList matches the given predicate, otherwise false is
+// returned.
+func (l *immutableList) Any(predicate func(element interface{}) bool) bool {
+    return l.delegate.Any(predicate)
+}
+
+// All returns true if every element of the ImmutableList matches the given predicate, otherwise false is returned.
+func (l *immutableList) All(predicate func(element interface{}) bool) bool {
+    return l.delegate.All(predicate)
+}
+
+// Map returns a new mutable List containing the resulting elements of applying the given function to the elements of
+// this ImmutableList.
+func (l *immutableList) Map(mapper func(element interface{}) interface{}) List {
+    return l.delegate.Map(mapper)
+}
+
+// MapError returns a new mutable List containing the resulting elements of applying the given function to the elements
+// of this ImmutableList. If the function returns an error, mapping stops and the error is returned along with the List
+// of the elements mapped before the failure.
+func (l *immutableList) MapError(mapper func(element interface{}) (interface{}, error)) (List, error) {
+    return l.delegate.MapError(mapper)
+}
+
+// ForEach performs the provided consumer function for each element of the ImmutableList.
+func (l *immutableList) ForEach(consumer func(element interface{})) {
+    l.delegate.ForEach(consumer)
+}
+
+// Stream returns a channel over which the elements of the ImmutableList are sent in iteration order, which is closed
+// once every element has been sent.
+func (l *immutableList) Stream() <-chan interface{} {
+    return collection.Stream(l, nil)
+}
+
+// ToSlice copies the elements of the ImmutableList into the slice pointed to by the provided destination (e.g.
+// *[]string), replacing its contents. The returned error will be non-nil if the destination is not a non-nil pointer to
+// a slice, or if any element is not assignable to the element type of the slice.
+func (l *immutableList) ToSlice(dst interface{}) error {
+    return l.delegate.ToSlice(dst)
+}
+
+// CopyTo copies as many elements of the ImmutableList as fit into the provided slice, and returns the number copied.
+func (l *immutableList) CopyTo(dst []interface{}) int {
+    return l.delegate.CopyTo(dst)
+}
+
+// Size returns the number of elements in the ImmutableList.
+func (l *immutableList) Size() int {
+    return l.delegate.Size()
+}
+
+// IsEmpty returns true if the ImmutableList contains no elements, otherwise false is returned.
+func (l *immutableList) IsEmpty() bool {
+    return l.delegate.IsEmpty()
+}
+
+// Clear does not remove any elements from the ImmutableList.
+func (l *immutableList) Clear() {}
+
+// Contains returns true if an element equivalent to the provided element exists in the ImmutableList, otherwise false
+// is returned.
+func (l *immutableList) Contains(element interface{}) bool {
+    return l.delegate.Contains(element)
+}
+
+// ContainsAll returns true if every element of the provided collection exists in the ImmutableList, otherwise false is
+// returned. A nil or empty collection is contained by every ImmutableList.
+func (l *immutableList) ContainsAll(other collection.Collection) bool {
+    return l.delegate.ContainsAll(other)
+}
+
+// ContainsAllOf returns a map from each of the provided elements to true if it exists in the ImmutableList, otherwise
+// false. Elements that are not hashable cannot be keys of the map, and are omitted.
+func (l *immutableList) ContainsAllOf(elements []interface{}) map[interface{}]bool {
+    return l.delegate.ContainsAllOf(elements)
+}
+
+// EqualsIgnoreOrder returns true if the provided List contains the same elements as the ImmutableList with the same
+// number of occurrences, regardless of their positions. A nil List is not equal to any List.
+func (l *immutableList) EqualsIgnoreOrder(other List) bool {
+    return other != nil && collection.Equals(l, other)
+}
+
+// Values returns a slice containing the elements in the ImmutableList in the iteration order. Modifying the returned
+// slice does not affect the ImmutableList.
+func (l *immutableList) Values() []interface{} {
+    return l.delegate.Values()
+}
+
+// Clone returns the ImmutableList, since it cannot be modified.
+func (l *immutableList) Clone() collection.Collection {
+    return l
+}
+
+// Iterator returns an Iterator positioned before the first element of the ImmutableList.
+func (l *immutableList) Iterator() collection.Iterator {
+    return l.delegate.Iterator()
+}
+
+// ReverseIterator returns an Iterator positioned after the last element of the ImmutableList, which traverses the
+// elements from the last to the first.
+func (l *immutableList) ReverseIterator() collection.Iterator {
+    return l.delegate.ReverseIterator()
+}
+
+// String returns a string representation of the ImmutableList.
+func (l *immutableList) String() string {
+    return fmt.Sprintf("%v", l.delegate)
+}
+
+// StringQuoted returns a string representation of the ImmutableList in which string elements are quoted and nil
+// elements are rendered as nil.
+func (l *immutableList) StringQuoted() string {
+    return l.delegate.StringQuoted()
+}
@@ -0,0 +1,103 @@
+package list
+
+import (
+    "testing"
+
+    "github.com/2speed/go-collection"
+)
+
+func TestImmutableList_Mutators(t *testing.T) {
+    values := []interface{}{ "samus", "jigglypuff", "r.o.b.", "mega man", "yoshi" }
+    list   := NewImmutableList(NewArrayListOf(values))
+
+    t.Run("Add", func(t *testing.T) {
+        assertError(t, list.Add("luffy"), collection.ErrorImmutable)
+    })
+
+    t.Run("AddAll", func(t *testing.T) {
+        assertError(t, list.AddAll(NewArrayListOf([]interface{}{ "luffy", "chopper" })), collection.ErrorImmutable)
+    })
+
+    t.Run("AddFirst", func(t *testing.T) {
+        assertError(t, list.AddFirst("luffy"), collection.ErrorImmutable)
+    })
+
+    t.Run("AddLast", func(t *testing.T) {
+        assertError(t, list.AddLast("luffy"), collection.ErrorImmutable)
+    })
+
+    t.Run("AddWithIndex", func(t *testing.T) {
+        assertError(t, list.AddWithIndex(1, "luffy"), collection.ErrorImmutable)
+    })
+
+    t.Run("RemoveWithIndex", func(t *testing.T) {
+        element, err := list.RemoveWithIndex(0)
+
+        assertError(t, err, collection.ErrorImmutable)
+        if element != nil {
+            t.Errorf("expected nil element, but found '%v'", element)
+        }
+    })
+
+    t.Run("Remove", func(t *testing.T) {
+        if list.Remove("samus") {
+            t.Error("expected result to be false")
+        }
+    })
+
+    t.Run("RemoveFirst,RemoveLast", func(t *testing.T) {
+        if list.RemoveFirst() != nil {
+            t.Error("expected nil from RemoveFirst")
+        }
+
+        if list.RemoveLast() != nil {
+            t.Error("expected nil from RemoveLast")
+        }
+    })
+
+    t.Run("Clear", func(t *testing.T) {
+        list.Clear()
+
+        if list.IsEmpty() {
+            t.Error("expected result to be false")
+        }
+    })
+
+    assertSize(t, list, len(values))
+    for i, expected := range values {
+        actual, err := list.ValueWithIndex(i)
+
+        assertError(t, err, nil)
+        if actual != expected {
+            t.Errorf("expected value '%v' at index %d, but found '%v'", expected, i, actual)
+        }
+    }
+}
+
+func TestImmutableList_Snapshot(t *testing.T) {
+    source := NewArrayListOf([]interface{}{ "samus", "yoshi" })
+    list   := NewImmutableList(source)
+
+    _ = source.Add("luffy")
+    assertSize(t, list, 2)
+    assertContains(t, list, "luffy", false)
+
+    values   := list.Values()
+    values[0] = "chopper"
+    assertContains(t, list, "samus", true)
+    assertContains(t, list, "chopper", false)
+}
+
+func TestImmutableList_Functional(t *testing.T) {
+    list := NewImmutableList(NewArrayListOf([]interface{}{ 1, 2, 3, 4 }))
+
+    evens := list.Filter(func(element interface{}) bool { return element.(int) % 2 == 0 })
+    assertError(t, evens.Add(6), nil)
+    assertSize(t, evens, 3)
+
+    doubled := list.Map(func(element interface{}) interface{} { return element.(int) * 2 })
+    assertError(t, doubled.Add(10), nil)
+    assertSize(t, doubled, 5)
+
+    assertSize(t, list, 4)
+}
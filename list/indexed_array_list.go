@@ -0,0 +1,400 @@
+package list
+
+import "github.com/2speed/go-collection"
+
+// IndexedArrayList is an ArrayList that maintains a hash index from each of its hashable elements to the position of
+// its first occurrence, so that Contains and IndexOf are O(1) for hashable elements (e.g. strings, numbers, and structs
+// thereof) rather than a linear scan. Elements that are not hashable (e.g. slices, maps, and pointers, which are
+// compared by the values they refer to) are not indexed, and are found with a linear scan. Appending an element keeps
+// the index current in O(1), while inserting or removing an element before the end of the IndexedArrayList updates the
+// positions of the elements after it. The Lists returned by Filter and Map are also IndexedArrayLists.
+// IndexedArrayList is not SliceBacked, since assignments through the backing slice would bypass the index.
+// IndexedArrayList does not make any guarantees for concurrent access.
+type indexedArrayList struct {
+    *arrayList
+
+    // UnsafeSlice shadows the method promoted from the embedded ArrayList, so that an IndexedArrayList does not
+    // satisfy SliceBacked.
+    UnsafeSlice struct{}
+
+    index map[interface{}]int
+}
+
+// NewIndexedArrayList creates a new IndexedArrayList.
+func NewIndexedArrayList() List {
+    return &indexedArrayList{
+        arrayList: &arrayList{ elements: make([]interface{}, 0) },
+        index:     make(map[interface{}]int),
+    }
+}
+
+// newIndexedArrayListOf creates a new IndexedArrayList whose backing slice is the backing slice of the provided
+// ArrayList, which must not be modified afterwards.
+func newIndexedArrayListOf(list List) List {
+    l := &indexedArrayList{
+        arrayList: list.(*arrayList),
+        index:     make(map[interface{}]int),
+    }
+    l.reindexFrom(0)
+
+    return l
+}
+
+// Add inserts the provided element into the IndexedArrayList.
+func (l *indexedArrayList) Add(element interface{}) error {
+    _ = l.arrayList.Add(element)
+    l.indexAt(l.Size() - 1)
+
+    return nil
+}
+
+// AddAll inserts all elements from the provided Collection into the IndexedArrayList. The elements of the provided
+// Collection are copied before they are inserted, so an IndexedArrayList may be added to itself.
+func (l *indexedArrayList) AddAll(c collection.Collection) error {
+    if c != nil {
+        for _, v := range c.Values() {
+            _ = l.Add(v)
+        }
+    }
+
+    return nil
+}
+
+// AddFirst inserts the provided element at the front (index == 0) of the IndexedArrayList. The positions of the
+// existing elements are increased by one.
+func (l *indexedArrayList) AddFirst(element interface{}) error {
+    return l.AddWithIndex(0, element)
+}
+
+// AddLast inserts the provided element at the end of the IndexedArrayList (index == IndexedArrayList.Size()).
+func (l *indexedArrayList) AddLast(element interface{}) error {
+    return l.Add(element)
+}
+
+// AddValues inserts the provided elements at the end of the IndexedArrayList in the order they are provided.
+func (l *indexedArrayList) AddValues(elements ...interface{}) error {
+    for _, v := range elements {
+        _ = l.Add(v)
+    }
+
+    return nil
+}
+
+// AddWithIndex inserts the provided element into the IndexedArrayList specified by index. The position of the elements
+// that were at positions index to IndexedArrayList.Size() - 1 increase by one. The returned error will be non-nil if
+// the provided index is outside the current bounds of the IndexedArrayList (index < 0 || index > IndexedArrayList.Size()).
+func (l *indexedArrayList) AddWithIndex(index int, element interface{}) error {
+    if err := l.arrayList.AddWithIndex(index, element); err != nil {
+        return err
+    }
+
+    l.reindexFrom(index)
+
+    return nil
+}
+
+// IndexOf returns the position of the first occurrence (if any) of an element equivalent to the provided element. The
+// returned error will be non-nil if provided element is not found in the IndexedArrayList, and the returned index will
+// be equal to collection.ElementNotFound.
+func (l *indexedArrayList) IndexOf(element interface{}) (int, error) {
+    if !collection.IsHashable(element) {
+        return l.arrayList.IndexOf(element)
+    }
+
+    if i, ok := l.index[element]; ok {
+        return i, nil
+    }
+
+    return collection.ElementNotFound, collection.ErrorElementNotFound
+}
+
+// Remove removes the first occurrence (if any) of an element equivalent to the provided element. If an element was
+// removed, the return value will be true, otherwise false will be returned.
+func (l *indexedArrayList) Remove(element interface{}) bool {
+    if i, err := l.IndexOf(element); err == nil {
+        _, err = l.RemoveWithIndex(i)
+
+        return err == nil
+    }
+
+    return false
+}
+
+// RemoveAll removes every occurrence of each element of the provided collection from the IndexedArrayList, and returns
+// the number of elements removed. A nil collection removes no elements.
+func (l *indexedArrayList) RemoveAll(other collection.Collection) int {
+    return collection.RemoveAll(l, other)
+}
+
+// RetainAll removes every element from the IndexedArrayList that does not exist in the provided collection, and returns
+// the number of elements removed. A nil collection is treated as an empty collection.
+func (l *indexedArrayList) RetainAll(other collection.Collection) int {
+    return collection.RetainAll(l, other)
+}
+
+// RemoveFirst removes the element at the front (index == 0) of the IndexedArrayList and returns it. If the
+// IndexedArrayList is empty (IndexedArrayList.Size() == 0), the return value will be nil.
+func (l *indexedArrayList) RemoveFirst() interface{} {
+    if l.Size() > 0 {
+        v, _ := l.RemoveWithIndex(0)
+
+        return v
+    }
+
+    return nil
+}
+
+// RemoveLast removes the element at the end (index == IndexedArrayList.Size() - 1) of the IndexedArrayList and returns
+// it. If the IndexedArrayList is empty (IndexedArrayList.Size() == 0), the return value will be nil.
+func (l *indexedArrayList) RemoveLast() interface{} {
+    if l.Size() > 0 {
+        v, _ := l.RemoveWithIndex(l.Size() - 1)
+
+        return v
+    }
+
+    return nil
+}
+
+// RemoveWithIndex removes the element at the provided index from the IndexedArrayList and returns it. The positions of
+// the elements originally at positions index + 1 to IndexedArrayList.Size() - 1 are decremented by 1. The returned
+// error will be non-nil if the provided index is outside the bounds of the IndexedArrayList
+// (index < 0 || index > IndexedArrayList.Size() - 1).
+func (l *indexedArrayList) RemoveWithIndex(index int) (interface{}, error) {
+    element, err := l.arrayList.RemoveWithIndex(index)
+    if err != nil {
+        return nil, err
+    }
+
+    if collection.IsHashable(element) && l.index[element] == index {
+        delete(l.index, element)
+    }
+    l.reindexFrom(index)
+
+    return element, nil
+}
+
+// RemoveFirstMatch removes the first element of the IndexedArrayList that matches the given predicate and returns it.
+// The positions of the elements after it are updated in the index.
+func (l *indexedArrayList) RemoveFirstMatch(predicate func(element interface{}) bool) (interface{}, bool) {
+    for i, element := range l.elements {
+        if predicate(element) {
+            _, _ = l.RemoveWithIndex(i)
+
+            return element, true
+        }
+    }
+
+    return nil, false
+}
+
+// RemoveRange removes the elements of the IndexedArrayList at positions from (inclusive) to to (exclusive). An empty
+// range (from == to) removes no elements. The returned error will be non-nil if the provided range is outside the
+// current bounds of the IndexedArrayList (from < 0 || from > to || to > IndexedArrayList.Size()).
+func (l *indexedArrayList) RemoveRange(from int, to int) error {
+    if err := l.checkRange(from, to); err != nil {
+        return err
+    }
+
+    l.unindexRange(from, to)
+    _ = l.arrayList.RemoveRange(from, to)
+    l.reindexFrom(from)
+
+    return nil
+}
+
+// Splice removes the elements of the IndexedArrayList at positions from (inclusive) to to (exclusive), and returns them
+// as a new IndexedArrayList. The returned error will be non-nil if the provided range is outside the current bounds of
+// the IndexedArrayList (from < 0 || from > to || to > IndexedArrayList.Size()).
+func (l *indexedArrayList) Splice(from int, to int) (List, error) {
+    if err := l.checkRange(from, to); err != nil {
+        return nil, err
+    }
+
+    list := newArrayListWithCapacity(to - from)
+    list.elements = append(list.elements, l.elements[from:to]...)
+    _ = l.RemoveRange(from, to)
+
+    return newIndexedArrayListOf(list), nil
+}
+
+// Compact removes every nil element from the IndexedArrayList, and returns the number of elements removed. The index is
+// rebuilt if any elements were removed, since the positions of the elements after the first nil element have shifted.
+func (l *indexedArrayList) Compact() int {
+    removed := l.arrayList.Compact()
+    if removed > 0 {
+        l.index = make(map[interface{}]int)
+        l.reindexFrom(0)
+    }
+
+    return removed
+}
+
+// InsertSorted inserts the provided element into the IndexedArrayList after any elements that are not greater than it
+// according to the provided comparator, and returns the position where the element was placed. The IndexedArrayList is
+// assumed to already be sorted by the comparator.
+func (l *indexedArrayList) InsertSorted(element interface{}, less func(a, b interface{}) bool) int {
+    index := l.upperBound(element, less)
+    _ = l.AddWithIndex(index, element)
+
+    return index
+}
+
+// Fill replaces every element of the IndexedArrayList with the provided value. The size of the IndexedArrayList is
+// unchanged.
+func (l *indexedArrayList) Fill(value interface{}) {
+    _ = l.FillRange(0, l.Size(), value)
+}
+
+// FillRange replaces the elements of the IndexedArrayList at positions from (inclusive) to to (exclusive) with the
+// provided value. The size of the IndexedArrayList is unchanged. The returned error will be non-nil if the provided
+// range is outside the current bounds of the IndexedArrayList (from < 0 || from > to || to > IndexedArrayList.Size()).
+func (l *indexedArrayList) FillRange(from int, to int, value interface{}) error {
+    if err := l.checkRange(from, to); err != nil {
+        return err
+    }
+
+    l.unindexRange(from, to)
+    _ = l.arrayList.FillRange(from, to, value)
+    l.reindexFrom(from)
+
+    return nil
+}
+
+// Apply replaces each element of the IndexedArrayList with the result of applying the provided function to it, and then
+// rebuilds the index. Unlike Map, the elements are overwritten in the backing slice of the IndexedArrayList.
+func (l *indexedArrayList) Apply(mapper func(element interface{}) interface{}) {
+    l.arrayList.Apply(mapper)
+
+    l.index = make(map[interface{}]int, len(l.index))
+    l.reindexFrom(0)
+}
+
+// Filter returns a new IndexedArrayList consisting of the elements of this IndexedArrayList that match the given
+// predicate.
+func (l *indexedArrayList) Filter(predicate func(element interface{}) bool) List {
+    return newIndexedArrayListOf(l.arrayList.Filter(predicate))
+}
+
+// FilterIndexed returns a new IndexedArrayList consisting of the elements of this IndexedArrayList that match the given
+// predicate, which is provided the position of each element in this IndexedArrayList along with the element.
+func (l *indexedArrayList) FilterIndexed(predicate func(index int, element interface{}) bool) List {
+    return newIndexedArrayListOf(l.arrayList.FilterIndexed(predicate))
+}
+
+// Map returns a new IndexedArrayList containing the resulting elements of applying the given function to the elements
+// of this IndexedArrayList.
+func (l *indexedArrayList) Map(mapper func(element interface{}) interface{}) List {
+    return newIndexedArrayListOf(l.arrayList.Map(mapper))
+}
+
+// MapError returns a new IndexedArrayList containing the resulting elements of applying the given function to the
+// elements of this IndexedArrayList. If the function returns an error, mapping stops and the error is returned along
+// with the IndexedArrayList of the elements mapped before the failure.
+func (l *indexedArrayList) MapError(mapper func(element interface{}) (interface{}, error)) (List, error) {
+    list, err := l.arrayList.MapError(mapper)
+
+    return newIndexedArrayListOf(list), err
+}
+
+// Clear removes all elements from the IndexedArrayList.
+func (l *indexedArrayList) Clear() {
+    l.arrayList.Clear()
+    l.index = make(map[interface{}]int)
+}
+
+// Clone returns a new IndexedArrayList containing the elements of the IndexedArrayList along with a copy of its index.
+// The elements themselves are not copied.
+func (l *indexedArrayList) Clone() collection.Collection {
+    index := make(map[interface{}]int, len(l.index))
+    for element, position := range l.index {
+        index[element] = position
+    }
+
+    return &indexedArrayList{ arrayList: &arrayList{ elements: l.Values() }, index: index }
+}
+
+// Contains returns true if an element equivalent to the provided element exists in the IndexedArrayList, otherwise
+// false is returned.
+func (l *indexedArrayList) Contains(element interface{}) bool {
+    _, err := l.IndexOf(element)
+
+    return err == nil
+}
+
+// ContainsAll returns true if every element of the provided collection exists in the IndexedArrayList, otherwise false
+// is returned. A nil or empty collection is contained by every IndexedArrayList.
+func (l *indexedArrayList) ContainsAll(other collection.Collection) bool {
+    return collection.ContainsAll(l, other)
+}
+
+// ContainsAllOf returns a map from each of the provided elements to true if it exists in the IndexedArrayList,
+// otherwise false. Elements that are not hashable cannot be keys of the map, and are omitted.
+func (l *indexedArrayList) ContainsAllOf(elements []interface{}) map[interface{}]bool {
+    return collection.ContainsAllOf(l, elements)
+}
+
+// UnmarshalJSON replaces the elements of the IndexedArrayList with the elements decoded from the provided JSON array
+// (see ArrayList.UnmarshalJSON).
+func (l *indexedArrayList) UnmarshalJSON(data []byte) error {
+    if err := l.arrayList.UnmarshalJSON(data); err != nil {
+        return err
+    }
+
+    l.index = make(map[interface{}]int)
+    l.reindexFrom(0)
+
+    return nil
+}
+
+// GobDecode replaces the elements of the IndexedArrayList with the elements decoded from the provided data, which must
+// have been encoded by GobEncode.
+func (l *indexedArrayList) GobDecode(data []byte) error {
+    if err := l.arrayList.GobDecode(data); err != nil {
+        return err
+    }
+
+    l.index = make(map[interface{}]int)
+    l.reindexFrom(0)
+
+    return nil
+}
+
+// indexAt adds the element at the provided position to the index, unless it is not hashable or an earlier occurrence
+// is already indexed.
+func (l *indexedArrayList) indexAt(position int) {
+    element := l.elements[position]
+    if !collection.IsHashable(element) {
+        return
+    }
+
+    if _, ok := l.index[element]; !ok {
+        l.index[element] = position
+    }
+}
+
+// unindexRange removes the index entries of the elements at positions from (inclusive) to to (exclusive) that are the
+// first occurrences of their elements.
+func (l *indexedArrayList) unindexRange(from int, to int) {
+    for i, element := range l.elements[from:to] {
+        if collection.IsHashable(element) && l.index[element] == from + i {
+            delete(l.index, element)
+        }
+    }
+}
+
+// reindexFrom updates the index for the elements at the provided position and after it, whose positions have shifted.
+// Entries of elements whose first occurrence is before the provided position are unaffected.
+func (l *indexedArrayList) reindexFrom(position int) {
+    for _, element := range l.elements[position:] {
+        if collection.IsHashable(element) {
+            if i, ok := l.index[element]; ok && i >= position {
+                delete(l.index, element)
+            }
+        }
+    }
+
+    for i := position; i < l.Size(); i++ {
+        l.indexAt(i)
+    }
+}
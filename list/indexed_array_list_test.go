@@ -0,0 +1,155 @@
+package list
+
+import (
+    "encoding/json"
+    "math/rand"
+    "testing"
+)
+
+func TestIndexedArrayList_IndexOf(t *testing.T) {
+    t.Run("Shifting", func(t *testing.T) {
+        list := NewIndexedArrayList()
+        for _, v := range []interface{}{ "a", "b", "c", "b", "d" } {
+            _ = list.Add(v)
+        }
+
+        assertIndex(t, list, "b", 1)
+        assertIndex(t, list, "d", 4)
+
+        _, err := list.RemoveWithIndex(1)
+        assertError(t, err, nil)
+        assertIndex(t, list, "a", 0)
+        assertIndex(t, list, "c", 1)
+        assertIndex(t, list, "b", 2)
+        assertIndex(t, list, "d", 3)
+
+        assertError(t, list.AddFirst("d"), nil)
+        assertIndex(t, list, "d", 0)
+        assertIndex(t, list, "a", 1)
+        assertIndex(t, list, "b", 3)
+
+        list.RemoveFirst()
+        assertIndex(t, list, "d", 3)
+        assertContentEquals(t, list, "[a, c, b, d]")
+
+        for _, index := range []int{ -1, list.Size() } {
+            if _, err := list.ValueWithIndex(index); err == nil {
+                t.Errorf("expected ValueWithIndex error for index %d", index)
+            }
+            if _, err := list.RemoveWithIndex(index); err == nil {
+                t.Errorf("expected RemoveWithIndex error for index %d", index)
+            }
+        }
+        assertContentEquals(t, list, "[a, c, b, d]")
+        assertIndex(t, list, "d", 3)
+    })
+
+    t.Run("Unhashable", func(t *testing.T) {
+        list  := NewIndexedArrayList()
+        value := 42
+        _ = list.Add([]int{ 1, 2 })
+        _ = list.Add(map[string]int{ "one": 1 })
+        _ = list.Add(&value)
+        _ = list.Add(nil)
+
+        other := 42
+        assertIndex(t, list, []int{ 1, 2 }, 0)
+        assertIndex(t, list, map[string]int{ "one": 1 }, 1)
+        assertIndex(t, list, &other, 2)
+        assertIndex(t, list, nil, 3)
+        assertContains(t, list, []int{ 2, 1 }, false)
+    })
+
+    t.Run("FillRange", func(t *testing.T) {
+        list := NewIndexedArrayList()
+        for _, v := range []interface{}{ "a", "b", "c", "b", "d" } {
+            _ = list.Add(v)
+        }
+
+        assertError(t, list.FillRange(1, 3, "d"), nil)
+        assertContentEquals(t, list, "[a, d, d, b, d]")
+        assertIndex(t, list, "d", 1)
+        assertIndex(t, list, "b", 3)
+        assertContains(t, list, "c", false)
+
+        list.Fill("e")
+        assertIndex(t, list, "e", 0)
+        assertContains(t, list, "a", false)
+    })
+
+    t.Run("Clear", func(t *testing.T) {
+        list := NewIndexedArrayList()
+        _ = list.Add("a")
+        list.Clear()
+
+        assertContains(t, list, "a", false)
+        _ = list.Add("b")
+        assertIndex(t, list, "b", 0)
+    })
+
+    t.Run("UnmarshalJSON", func(t *testing.T) {
+        list := NewIndexedArrayList()
+        _ = list.Add("a")
+
+        assertError(t, json.Unmarshal([]byte(`["b", "c"]`), list), nil)
+        assertContains(t, list, "a", false)
+        assertIndex(t, list, "c", 1)
+    })
+}
+
+func TestIndexedArrayList_Equivalence(t *testing.T) {
+    random   := rand.New(rand.NewSource(7))
+    indexed  := NewIndexedArrayList()
+    expected := NewArrayList()
+
+    for i := 0; i < 2000; i++ {
+        value := random.Intn(20)
+        switch random.Intn(6) {
+        case 0, 1:
+            _ = indexed.Add(value)
+            _ = expected.Add(value)
+        case 2:
+            _ = indexed.AddFirst(value)
+            _ = expected.AddFirst(value)
+        case 3:
+            index := random.Intn(expected.Size() + 1)
+            _ = indexed.AddWithIndex(index, value)
+            _ = expected.AddWithIndex(index, value)
+        case 4:
+            indexed.Remove(value)
+            expected.Remove(value)
+        case 5:
+            if expected.Size() > 0 {
+                index := random.Intn(expected.Size())
+                _, _ = indexed.RemoveWithIndex(index)
+                _, _ = expected.RemoveWithIndex(index)
+            }
+        }
+
+        for value := 0; value < 20; value++ {
+            expectedIndex, _ := expected.IndexOf(value)
+            if actualIndex, _ := indexed.IndexOf(value); actualIndex != expectedIndex {
+                t.Fatalf("expected index of '%d' for %d, but found '%d' in %v", expectedIndex, value, actualIndex, indexed)
+            }
+        }
+    }
+}
+
+func BenchmarkArrayList_Contains(b *testing.B) {
+    benchmarkContains(b, NewArrayList())
+}
+
+func BenchmarkIndexedArrayList_Contains(b *testing.B) {
+    benchmarkContains(b, NewIndexedArrayList())
+}
+
+func benchmarkContains(b *testing.B, list List) {
+    for i := 0; i < 10000; i++ {
+        _ = list.Add(i)
+    }
+
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        list.Contains(i % 10000)
+    }
+}
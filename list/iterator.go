@@ -0,0 +1,120 @@
+package list
+
+import "github.com/2speed/go-collection"
+
+// positional defines the behavior of a collection whose elements are accessed via their position.
+type positional interface {
+    ValueWithIndex(index int) (interface{}, error)
+    Size() int
+}
+
+// indexIterator traverses the elements of a positional collection by index. The traversal reflects modifications made
+// to the collection, so an element inserted or removed before the current position shifts the remaining elements.
+type indexIterator struct {
+    elements positional
+    index    int
+}
+
+func newIndexIterator(elements positional) collection.Iterator {
+    return &indexIterator{ elements: elements }
+}
+
+// HasNext returns true if the traversal has further elements, otherwise false is returned.
+func (i *indexIterator) HasNext() bool {
+    return i.index < i.elements.Size()
+}
+
+// Next advances the traversal and returns the next element. If the traversal has no further elements, the return value
+// will be nil.
+func (i *indexIterator) Next() interface{} {
+    if !i.HasNext() {
+        return nil
+    }
+
+    element, _ := i.elements.ValueWithIndex(i.index)
+    i.index++
+
+    return element
+}
+
+// reverseIndexIterator traverses the elements of a positional collection by index from the last to the first. If
+// elements are removed from the collection during the traversal, the traversal resumes from the new last element.
+type reverseIndexIterator struct {
+    elements positional
+    index    int
+}
+
+func newReverseIndexIterator(elements positional) collection.Iterator {
+    return &reverseIndexIterator{ elements: elements, index: elements.Size() }
+}
+
+// HasNext returns true if the traversal has further elements, otherwise false is returned.
+func (i *reverseIndexIterator) HasNext() bool {
+    if i.index > i.elements.Size() {
+        i.index = i.elements.Size()
+    }
+
+    return i.index > 0
+}
+
+// Next advances the traversal and returns the next element. If the traversal has no further elements, the return value
+// will be nil.
+func (i *reverseIndexIterator) Next() interface{} {
+    if !i.HasNext() {
+        return nil
+    }
+
+    i.index--
+    element, _ := i.elements.ValueWithIndex(i.index)
+
+    return element
+}
+
+// linkedIterator traverses the elements of a LinkedList by following the chain of nodes.
+type linkedIterator struct {
+    list *linkedList
+    next *linkedNode
+}
+
+// HasNext returns true if the traversal has further elements, otherwise false is returned.
+func (i *linkedIterator) HasNext() bool {
+    return i.next != i.list.tail
+}
+
+// Next advances the traversal and returns the next element. If the traversal has no further elements, the return value
+// will be nil.
+func (i *linkedIterator) Next() interface{} {
+    if !i.HasNext() {
+        return nil
+    }
+
+    element := i.next.element
+    i.next = i.next.next
+
+    return element
+}
+
+// reverseLinkedIterator traverses the elements of a LinkedList from the last to the first by following the chain of
+// nodes backwards.
+type reverseLinkedIterator struct {
+    list *linkedList
+    next *linkedNode
+}
+
+// HasNext returns true if the traversal has further elements, otherwise false is returned.
+func (i *reverseLinkedIterator) HasNext() bool {
+    return i.next != i.list.head
+}
+
+// Next advances the traversal and returns the next element. If the traversal has no further elements, the return value
+// will be nil.
+func (i *reverseLinkedIterator) Next() interface{} {
+    if !i.HasNext() {
+        return nil
+    }
+
+    element := i.next.element
+    i.next = i.next.previous
+
+    return element
+}
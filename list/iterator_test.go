@@ -0,0 +1,124 @@
+package list
+
+import (
+    "reflect"
+    "testing"
+
+    "github.com/2speed/go-collection"
+)
+
+func TestIterator(t *testing.T) {
+    values := []interface{}{ 40, 10, 30, 30, 50, 20 }
+
+    circularList := NewCircularList(len(values))
+    _ = circularList.AddAll(NewArrayListOf(values))
+
+    sortedList := NewSortedList(byInt)
+    _ = sortedList.AddAll(NewArrayListOf(values))
+
+    for name, list := range map[string]collection.Collection{
+        "ArrayList":        NewArrayListOf(values),
+        "LinkedList":       NewLinkedListOf(values),
+        "CircularList":     circularList,
+        "SortedList":       sortedList,
+        "ImmutableList":    NewImmutableList(NewArrayListOf(values)),
+        "SynchronizedList": NewSynchronizedList(NewArrayListOf(values)),
+    } {
+        t.Run(name, func(t *testing.T) {
+            actual   := make([]interface{}, 0)
+            iterator := list.Iterator()
+            for iterator.HasNext() {
+                actual = append(actual, iterator.Next())
+            }
+
+            if !reflect.DeepEqual(actual, list.Values()) {
+                t.Errorf("expected '%v', but found '%v'", list.Values(), actual)
+            }
+
+            if iterator.Next() != nil {
+                t.Error("expected nil after the last element")
+            }
+        })
+    }
+}
+
+func TestReverseIterator(t *testing.T) {
+    values := []interface{}{ 40, 10, 30, 30, 50, 20 }
+
+    circularList := NewCircularListWithOverwrite(len(values) - 2)
+    _ = circularList.AddAll(NewArrayListOf(values))
+
+    indexedList := NewIndexedArrayList()
+    _ = indexedList.AddAll(NewArrayListOf(values))
+
+    copyOnWriteList := NewCopyOnWriteList()
+    _ = copyOnWriteList.AddAll(NewArrayListOf(values))
+
+    for name, list := range map[string]List{
+        "ArrayList":        NewArrayListOf(values),
+        "EmptyArrayList":   NewArrayList(),
+        "LinkedList":       NewLinkedListOf(values),
+        "EmptyLinkedList":  NewLinkedList(),
+        "CircularList":     circularList,
+        "IndexedArrayList": indexedList,
+        "CopyOnWriteList":  copyOnWriteList,
+        "ImmutableList":    NewImmutableList(NewArrayListOf(values)),
+        "SynchronizedList": NewSynchronizedList(NewLinkedListOf(values)),
+    } {
+        t.Run(name, func(t *testing.T) {
+            forward := make([]interface{}, 0)
+            for iterator := list.Iterator(); iterator.HasNext(); {
+                forward = append(forward, iterator.Next())
+            }
+
+            backward := make([]interface{}, 0)
+            iterator := list.ReverseIterator()
+            for iterator.HasNext() {
+                backward = append(backward, iterator.Next())
+            }
+
+            if len(backward) != len(forward) {
+                t.Fatalf("expected '%d' elements, but found '%d'", len(forward), len(backward))
+            }
+
+            for i := range forward {
+                if backward[i] != forward[len(forward) - 1 - i] {
+                    t.Errorf("expected '%v' to be the mirror of '%v'", backward, forward)
+                    break
+                }
+            }
+
+            if iterator.Next() != nil {
+                t.Error("expected nil after the first element")
+            }
+        })
+    }
+}
+
+func TestReverseIterator_Modification(t *testing.T) {
+    list     := NewArrayListOf([]interface{}{ "samus", "luffy", "yoshi", "kirby" })
+    iterator := list.ReverseIterator()
+
+    if actual := iterator.Next(); actual != "kirby" {
+        t.Errorf("expected 'kirby', but found '%v'", actual)
+    }
+
+    _ = list.RemoveRange(1, 4)
+    if actual := iterator.Next(); actual != "samus" || iterator.HasNext() {
+        t.Errorf("expected 'samus' as the last element, but found '%v'", actual)
+    }
+}
+
+func TestIterator_Modification(t *testing.T) {
+    list     := NewArrayListOf([]interface{}{ "samus", "luffy", "yoshi" })
+    iterator := list.Iterator()
+
+    if actual := iterator.Next(); actual != "samus" {
+        t.Errorf("expected 'samus', but found '%v'", actual)
+    }
+
+    _, _ = list.RemoveWithIndex(0)
+    if actual := iterator.Next(); actual != "yoshi" || iterator.HasNext() {
+        t.Errorf("expected 'yoshi' as the last element, but found '%v'", actual)
+    }
+}
@@ -0,0 +1,589 @@
+package list
+
+import (
+    "reflect"
+
+    "github.com/2speed/go-collection"
+    "github.com/pkg/errors"
+)
+
+// LinkedList is an implementation of a List whose elements are maintained by a chain of doubly-linked nodes. Much like
+// the leaf nodes of a Trie, the chain is bounded by sentinel head and tail nodes so that insertion and removal at
+// either end of the LinkedList never requires special handling of an empty chain. As a result, AddFirst, AddLast,
+// RemoveFirst, and RemoveLast are O(1), whereas positional access (e.g. ValueWithIndex) is O(n). LinkedList does not
+// make any guarantees for concurrent access.
+type linkedList struct {
+    head *linkedNode
+    tail *linkedNode
+    size int
+}
+
+type linkedNode struct {
+    element  interface{}
+    next     *linkedNode
+    previous *linkedNode
+}
+
+// NewLinkedList creates a new LinkedList.
+func NewLinkedList() List {
+    head := &linkedNode{}
+    tail := &linkedNode{}
+
+    head.next     = tail
+    tail.previous = head
+
+    return &linkedList{ head: head, tail: tail }
+}
+
+// NewLinkedListOf creates a new LinkedList containing the provided elements.
+func NewLinkedListOf(elements interface{}) List {
+    l := NewLinkedList()
+    if elements != nil {
+        els := reflect.ValueOf(elements)
+
+        if els.Kind() == reflect.Interface {
+            els = els.Elem()
+        }
+
+        if els.Kind() == reflect.Slice {
+            for i := 0; i < els.Len(); i++ {
+                _ = l.Add(els.Index(i).Interface())
+            }
+        } else {
+            _ = l.Add(elements)
+        }
+    }
+
+    return l
+}
+
+// NewLinkedListFrom creates a new LinkedList containing the elements from the provided Collection.
+func NewLinkedListFrom(collection collection.Collection) List {
+    var elements []interface{}
+    if collection != nil {
+        elements = collection.Values()
+    }
+
+    return NewLinkedListOf(elements)
+}
+
+// Add inserts the provided element at the end of the LinkedList.
+func (l *linkedList) Add(element interface{}) error {
+    return l.AddLast(element)
+}
+
+// AddAll inserts all elements from the provided Collection at the end of the LinkedList. The elements of the provided
+// Collection are copied before they are inserted, so a LinkedList may be added to itself.
+func (l *linkedList) AddAll(collection collection.Collection) error {
+    if collection != nil {
+        for _, v := range collection.Values() {
+            l.insertBefore(l.tail, v)
+        }
+    }
+
+    return nil
+}
+
+// AddFirst inserts the provided element at the front (index == 0) of the LinkedList. The positions of the existing
+// elements are increased by one.
+func (l *linkedList) AddFirst(element interface{}) error {
+    l.insertBefore(l.head.next, element)
+
+    return nil
+}
+
+// AddLast inserts the provided element at the end of the LinkedList (index == LinkedList.Size()).
+func (l *linkedList) AddLast(element interface{}) error {
+    l.insertBefore(l.tail, element)
+
+    return nil
+}
+
+// AddValues inserts the provided elements at the end of the LinkedList in the order they are provided.
+func (l *linkedList) AddValues(elements ...interface{}) error {
+    for _, v := range elements {
+        l.insertBefore(l.tail, v)
+    }
+
+    return nil
+}
+
+// AddWithIndex inserts the provided element into the LinkedList specified by index. The position of the elements that
+// were at positions index to LinkedList.Size() - 1 increase by one. The returned error will be non-nil if the provided
+// index is outside the current bounds of the LinkedList (index < 0 || index > LinkedList.Size()).
+func (l *linkedList) AddWithIndex(index int, element interface{}) error {
+    if index < 0 || index > l.Size() {
+        return l.outOfBounds(index)
+    }
+
+    if index == l.Size() {
+        l.insertBefore(l.tail, element)
+    } else {
+        l.insertBefore(l.nodeWithIndex(index), element)
+    }
+
+    return nil
+}
+
+// ValueWithIndex returns the element at the position specified by the provided index. The returned error will be
+// non-nil if the provided index is outside the current bounds of the LinkedList
+// (index < 0 || index > LinkedList.Size() - 1).
+func (l *linkedList) ValueWithIndex(index int) (interface{}, error) {
+    if err := l.checkBounds(index); err != nil {
+        return nil, err
+    }
+
+    return l.nodeWithIndex(index).element, nil
+}
+
+// IndexOf returns the position of the first occurrence (if any) of an element equivalent to the provided element. The
+// returned error will be non-nil if provided element is not found in the LinkedList, and the returned index will be
+// equal to collection.ElementNotFound.
+func (l *linkedList) IndexOf(element interface{}) (int, error) {
+    i, _ := l.findFirst(element)
+    if i == collection.ElementNotFound {
+        return i, collection.ErrorElementNotFound
+    }
+
+    return i, nil
+}
+
+// Remove removes the first occurrence (if any) of an element equivalent to the provided element. If an element was
+// removed, the return value will be true, otherwise false will be returned.
+func (l *linkedList) Remove(element interface{}) bool {
+    if _, n := l.findFirst(element); n != nil {
+        l.unlink(n)
+
+        return true
+    }
+
+    return false
+}
+
+// RemoveAll removes every occurrence of each element of the provided collection from the LinkedList, and returns the
+// number of elements removed. A nil collection removes no elements.
+func (l *linkedList) RemoveAll(other collection.Collection) int {
+    return collection.RemoveAll(l, other)
+}
+
+// RetainAll removes every element from the LinkedList that does not exist in the provided collection, and returns the
+// number of elements removed. A nil collection is treated as an empty collection.
+func (l *linkedList) RetainAll(other collection.Collection) int {
+    return collection.RetainAll(l, other)
+}
+
+// RemoveFirst removes the element at the front (index == 0) of the LinkedList and returns it. If the LinkedList is
+// empty (LinkedList.Size() == 0), the return value will be nil.
+func (l *linkedList) RemoveFirst() interface{} {
+    if l.Size() > 0 {
+        return l.unlink(l.head.next)
+    }
+
+    return nil
+}
+
+// RemoveLast removes the element at the end (index == LinkedList.Size() - 1) of the LinkedList and returns it. If the
+// LinkedList is empty (LinkedList.Size() == 0), the return value will be nil.
+func (l *linkedList) RemoveLast() interface{} {
+    if l.Size() > 0 {
+        return l.unlink(l.tail.previous)
+    }
+
+    return nil
+}
+
+// RemoveWithIndex removes the element at the provided index from the LinkedList and returns it. The positions of the
+// elements originally at positions index + 1 to LinkedList.Size() - 1 are decremented by 1. The returned error will be
+// non-nil if the provided index is outside the bounds of the LinkedList (index < 0 || index > LinkedList.Size() - 1).
+func (l *linkedList) RemoveWithIndex(index int) (interface{}, error) {
+    if err := l.checkBounds(index); err != nil {
+        return nil, err
+    }
+
+    return l.unlink(l.nodeWithIndex(index)), nil
+}
+
+// RemoveFirstMatch removes the first element of the LinkedList that matches the given predicate and returns it. The
+// node of the element is unlinked as soon as it is found.
+func (l *linkedList) RemoveFirstMatch(predicate func(element interface{}) bool) (interface{}, bool) {
+    for n := l.head.next; n != l.tail; n = n.next {
+        if predicate(n.element) {
+            return l.unlink(n), true
+        }
+    }
+
+    return nil, false
+}
+
+// RemoveRange removes the elements of the LinkedList at positions from (inclusive) to to (exclusive). An empty range
+// (from == to) removes no elements. The returned error will be non-nil if the provided range is outside the current
+// bounds of the LinkedList (from < 0 || from > to || to > LinkedList.Size()).
+func (l *linkedList) RemoveRange(from int, to int) error {
+    if err := l.checkRange(from, to); err != nil {
+        return err
+    }
+
+    if from == to {
+        return nil
+    }
+
+    n := l.nodeWithIndex(from)
+    for i := from; i < to; i++ {
+        next := n.next
+        l.unlink(n)
+        n = next
+    }
+
+    return nil
+}
+
+// Splice removes the elements of the LinkedList at positions from (inclusive) to to (exclusive), and returns them as a
+// new LinkedList. The returned error will be non-nil if the provided range is outside the current bounds of the
+// LinkedList (from < 0 || from > to || to > LinkedList.Size()).
+func (l *linkedList) Splice(from int, to int) (List, error) {
+    if err := l.checkRange(from, to); err != nil {
+        return nil, err
+    }
+
+    list := NewLinkedList()
+    if from == to {
+        return list, nil
+    }
+
+    n := l.nodeWithIndex(from)
+    for i := from; i < to; i++ {
+        next := n.next
+        _ = list.Add(l.unlink(n))
+        n = next
+    }
+
+    return list, nil
+}
+
+// Compact removes every nil element from the LinkedList, and returns the number of elements removed.
+func (l *linkedList) Compact() int {
+    removed := 0
+    for n := l.head.next; n != l.tail; {
+        next := n.next
+        if n.element == nil {
+            l.unlink(n)
+            removed++
+        }
+        n = next
+    }
+
+    return removed
+}
+
+// InsertSorted inserts the provided element into the LinkedList after any elements that are not greater than it
+// according to the provided comparator, and returns the position where the element was placed. Since the LinkedList
+// does not support random access, the insertion position is found by walking the LinkedList from the front, which is
+// assumed to already be sorted by the comparator.
+func (l *linkedList) InsertSorted(element interface{}, less func(a, b interface{}) bool) int {
+    index := 0
+    n     := l.head.next
+    for ; n != l.tail && !less(element, n.element); n = n.next {
+        index++
+    }
+    l.insertBefore(n, element)
+
+    return index
+}
+
+// Fill replaces every element of the LinkedList with the provided value. The size of the LinkedList is unchanged.
+func (l *linkedList) Fill(value interface{}) {
+    for n := l.head.next; n != l.tail; n = n.next {
+        n.element = value
+    }
+}
+
+// FillRange replaces the elements of the LinkedList at positions from (inclusive) to to (exclusive) with the provided
+// value. The size of the LinkedList is unchanged. The returned error will be non-nil if the provided range is outside
+// the current bounds of the LinkedList (from < 0 || from > to || to > LinkedList.Size()).
+func (l *linkedList) FillRange(from int, to int, value interface{}) error {
+    if err := l.checkRange(from, to); err != nil {
+        return err
+    }
+
+    if from == to {
+        return nil
+    }
+
+    n := l.nodeWithIndex(from)
+    for i := from; i < to; i++ {
+        n.element = value
+        n = n.next
+    }
+
+    return nil
+}
+
+// Apply replaces each element of the LinkedList with the result of applying the provided function to it. Unlike Map,
+// the elements are overwritten in the existing nodes of the LinkedList.
+func (l *linkedList) Apply(mapper func(element interface{}) interface{}) {
+    for n := l.head.next; n != l.tail; n = n.next {
+        n.element = mapper(n.element)
+    }
+}
+
+// Filter returns a new LinkedList consisting of the elements of this LinkedList that match the given predicate.
+func (l *linkedList) Filter(predicate func(element interface{}) bool) List {
+    list := NewLinkedList()
+
+    l.ForEach(func(element interface{}) {
+        if predicate(element) {
+            _ = list.Add(element)
+        }
+    })
+
+    return list
+}
+
+// FilterIndexed returns a new LinkedList consisting of the elements of this LinkedList that match the given predicate,
+// which is provided the position of each element in this LinkedList along with the element.
+func (l *linkedList) FilterIndexed(predicate func(index int, element interface{}) bool) List {
+    index := -1
+
+    return l.Filter(func(element interface{}) bool {
+        index++
+        return predicate(index, element)
+    })
+}
+
+// Find returns the first element of the LinkedList that matches the given predicate, and true if such an element
+// exists.
+func (l *linkedList) Find(predicate func(element interface{}) bool) (interface{}, bool) {
+    for n := l.head.next; n != l.tail; n = n.next {
+        if predicate(n.element) {
+            return n.element, true
+        }
+    }
+
+    return nil, false
+}
+
+// Any returns true if at least one element of the LinkedList matches the given predicate, otherwise false is returned.
+func (l *linkedList) Any(predicate func(element interface{}) bool) bool {
+    _, found := l.Find(predicate)
+
+    return found
+}
+
+// All returns true if every element of the LinkedList matches the given predicate, otherwise false is returned.
+func (l *linkedList) All(predicate func(element interface{}) bool) bool {
+    _, found := l.Find(func(element interface{}) bool { return !predicate(element) })
+
+    return !found
+}
+
+// Map returns a new LinkedList containing the resulting elements of applying the given function to the elements of
+// this LinkedList.
+func (l *linkedList) Map(mapper func(element interface{}) interface{}) List {
+    list := NewLinkedList()
+
+    l.ForEach(func(element interface{}) { _ = list.Add(mapper(element)) })
+
+    return list
+}
+
+// MapError returns a new LinkedList containing the resulting elements of applying the given function to the elements of
+// this LinkedList. If the function returns an error, mapping stops and the error is returned along with the LinkedList
+// of the elements mapped before the failure.
+func (l *linkedList) MapError(mapper func(element interface{}) (interface{}, error)) (List, error) {
+    list := NewLinkedList()
+
+    for n := l.head.next; n != l.tail; n = n.next {
+        element, err := mapper(n.element)
+        if err != nil {
+            return list, err
+        }
+        _ = list.Add(element)
+    }
+
+    return list, nil
+}
+
+// ForEach performs the provided consumer function for each element of the LinkedList.
+func (l *linkedList) ForEach(consumer func(element interface{})) {
+    for n := l.head.next; n != l.tail; n = n.next {
+        consumer(n.element)
+    }
+}
+
+// Stream returns a channel over which the elements of the LinkedList are sent in iteration order, which is closed once
+// every element has been sent. The LinkedList must not be modified until the channel is closed.
+func (l *linkedList) Stream() <-chan interface{} {
+    return collection.Stream(l, nil)
+}
+
+// ToSlice copies the elements of the LinkedList into the slice pointed to by the provided destination (e.g. *[]string),
+// replacing its contents. The returned error will be non-nil if the destination is not a non-nil pointer to a slice, or
+// if any element is not assignable to the element type of the slice.
+func (l *linkedList) ToSlice(dst interface{}) error {
+    return toSlice(l.Values(), dst)
+}
+
+// CopyTo copies as many elements of the LinkedList as fit into the provided slice, and returns the number copied.
+func (l *linkedList) CopyTo(dst []interface{}) int {
+    n := 0
+    for node := l.head.next; n < len(dst) && node != l.tail; node = node.next {
+        dst[n] = node.element
+        n++
+    }
+
+    return n
+}
+
+// Size returns the number of elements in the LinkedList.
+func (l *linkedList) Size() int {
+    return l.size
+}
+
+// IsEmpty returns true if the LinkedList contains no elements, otherwise false is returned.
+func (l *linkedList) IsEmpty() bool {
+    return l.Size() == 0
+}
+
+// Clear removes all elements from the LinkedList.
+func (l *linkedList) Clear() {
+    l.head.next     = l.tail
+    l.tail.previous = l.head
+    l.size          = 0
+}
+
+// Contains returns true if an element equivalent to the provided element exists in the LinkedList, otherwise false is
+// returned.
+func (l *linkedList) Contains(element interface{}) bool {
+    _, n := l.findFirst(element)
+
+    return n != nil
+}
+
+// ContainsAll returns true if every element of the provided collection exists in the LinkedList, otherwise false is
+// returned. A nil or empty collection is contained by every LinkedList.
+func (l *linkedList) ContainsAll(other collection.Collection) bool {
+    return collection.ContainsAll(l, other)
+}
+
+// ContainsAllOf returns a map from each of the provided elements to true if it exists in the LinkedList, otherwise
+// false. Elements that are not hashable cannot be keys of the map, and are omitted.
+func (l *linkedList) ContainsAllOf(elements []interface{}) map[interface{}]bool {
+    return collection.ContainsAllOf(l, elements)
+}
+
+// EqualsIgnoreOrder returns true if the provided List contains the same elements as the LinkedList with the same number
+// of occurrences, regardless of their positions. A nil List is not equal to any List.
+func (l *linkedList) EqualsIgnoreOrder(other List) bool {
+    return other != nil && collection.Equals(l, other)
+}
+
+// Values returns a slice containing the elements in the LinkedList in the iteration order.
+func (l *linkedList) Values() []interface{} {
+    elements := make([]interface{}, 0, l.Size())
+    l.ForEach(func(element interface{}) {
+        elements = append(elements, element)
+    })
+
+    return elements
+}
+
+// Clone returns a new LinkedList containing the elements of the LinkedList. The elements themselves are not copied.
+func (l *linkedList) Clone() collection.Collection {
+    return NewLinkedListFrom(l)
+}
+
+// Iterator returns an Iterator positioned before the first element of the LinkedList.
+func (l *linkedList) Iterator() collection.Iterator {
+    return &linkedIterator{ list: l, next: l.head.next }
+}
+
+// ReverseIterator returns an Iterator positioned after the last element of the LinkedList, which traverses the elements
+// from the last to the first.
+func (l *linkedList) ReverseIterator() collection.Iterator {
+    return &reverseLinkedIterator{ list: l, next: l.tail.previous }
+}
+
+// String returns a string representation of the LinkedList in it's current state.
+func (l *linkedList) String() string {
+    return collection.Format(l)
+}
+
+// StringQuoted returns a string representation of the LinkedList in which string elements are quoted and nil elements are
+// rendered as nil.
+func (l *linkedList) StringQuoted() string {
+    return stringQuoted(l.Values())
+}
+
+func (l *linkedList) checkBounds(index int) error {
+    if index < 0 || index >= l.Size() {
+        return l.outOfBounds(index)
+    }
+
+    return nil
+}
+
+func (l *linkedList) checkRange(from int, to int) error {
+    if from < 0 || from > to || to > l.Size() {
+        return errors.Errorf("range out of bounds [*LinkedList.Size() = %v, requested range = [%v, %v)]", l.Size(), from, to)
+    }
+
+    return nil
+}
+
+func (l *linkedList) outOfBounds(index int) error {
+    return errors.Errorf("index out of bounds [*LinkedList.Size() = %v, requested index = %v]", l.Size(), index)
+}
+
+func (l *linkedList) findFirst(element interface{}) (int, *linkedNode) {
+    i := 0
+    for n := l.head.next; n != l.tail; n = n.next {
+        if reflect.DeepEqual(n.element, element) {
+            return i, n
+        }
+        i++
+    }
+
+    return collection.ElementNotFound, nil
+}
+
+// nodeWithIndex walks from whichever end of the chain is closest to the provided index. The index is assumed to be
+// within bounds.
+func (l *linkedList) nodeWithIndex(index int) *linkedNode {
+    if index < l.Size() / 2 {
+        n := l.head.next
+        for i := 0; i < index; i++ {
+            n = n.next
+        }
+
+        return n
+    }
+
+    n := l.tail.previous
+    for i := l.Size() - 1; i > index; i-- {
+        n = n.previous
+    }
+
+    return n
+}
+
+func (l *linkedList) insertBefore(successor *linkedNode, element interface{}) {
+    n := &linkedNode{ element: element, next: successor, previous: successor.previous }
+
+    successor.previous.next = n
+    successor.previous      = n
+    l.size++
+}
+
+func (l *linkedList) unlink(n *linkedNode) interface{} {
+    n.previous.next = n.next
+    n.next.previous = n.previous
+    l.size--
+
+    element := n.element
+
+    n.element  = nil
+    n.next     = nil
+    n.previous = nil
+
+    return element
+}
@@ -0,0 +1,263 @@
+package list
+
+import (
+    "fmt"
+    "strings"
+    "testing"
+)
+
+func TestLinkedList_Add(t *testing.T) {
+    elements := []element{
+        { value: "piranha plant", position: 0 },
+        { value: "samus",         position: 1 },
+        { value: "jigglypuff",    position: 2 },
+        { value: "r.o.b.",        position: 3 },
+        { value: "mega man",      position: 4 },
+        { value: "yoshi",         position: 5 },
+    }
+
+    t.Run("Add", func(t *testing.T) {
+        list    := NewLinkedListOf(elements)
+        element := element{ value: "gumball", position: list.Size() }
+        err     := list.Add(element)
+
+        assertError(t, err, nil)
+        assertSize(t, list, 7)
+        assertContains(t, list, element, true)
+        assertIndex(t, list, element, 6)
+    })
+
+    t.Run("AddFirst", func(t *testing.T) {
+        list    := NewLinkedListOf(elements)
+        element := element{ value: "luffy", position: 0 }
+        err     := list.AddFirst(element)
+
+        assertError(t, err, nil)
+        assertSize(t, list, 7)
+        assertContains(t, list, element, true)
+        assertIndex(t, list, element, 0)
+        assertIndex(t, list, elements[0], 1)
+    })
+
+    t.Run("AddLast", func(t *testing.T) {
+        list    := NewLinkedListOf(elements)
+        element := element{ value: "snorlax", position: list.Size() }
+        err     := list.AddLast(element)
+
+        assertError(t, err, nil)
+        assertSize(t, list, 7)
+        assertContains(t, list, element, true)
+        assertIndex(t, list, element, 6)
+    })
+
+    t.Run("AddWithIndex", func(t *testing.T) {
+        list    := NewLinkedListOf(elements)
+        index   := 3
+        element := element{ value: "chopper", position: index }
+        err     := list.AddWithIndex(index, element)
+
+        assertError(t, err, nil)
+        assertSize(t, list, 7)
+        assertContains(t, list, element, true)
+        assertIndex(t, list, element, index)
+        assertIndex(t, list, elements[3], index + 1)
+    })
+
+    t.Run("AddWithIndex,OutOfBounds", func(t *testing.T) {
+        list := NewLinkedListOf(elements)
+
+        if err := list.AddWithIndex(-1, "zoro"); err == nil {
+            t.Error("expected error for index -1")
+        }
+
+        if err := list.AddWithIndex(list.Size() + 1, "zoro"); err == nil {
+            t.Errorf("expected error for index %d", list.Size() + 1)
+        }
+
+        assertSize(t, list, 6)
+    })
+
+    t.Run("AddAll", func(t *testing.T) {
+        list        := NewLinkedListOf(elements)
+        newElements := []element{
+            { value: "gumball", position: 6 },
+            { value: "luffy",   position: 7 },
+            { value: "chopper", position: 8 },
+        }
+        err := list.AddAll(NewArrayListOf(newElements))
+
+        assertError(t, err, nil)
+        assertSize(t, list, 9)
+
+        assertContains(t, list, newElements[0], true)
+        assertIndex(t, list, newElements[0], newElements[0].position)
+
+        assertContains(t, list, newElements[1], true)
+        assertIndex(t, list, newElements[1], newElements[1].position)
+
+        assertContains(t, list, newElements[2], true)
+        assertIndex(t, list, newElements[2], newElements[2].position)
+    })
+}
+
+func TestLinkedList_ValueWithIndex(t *testing.T) {
+    values := []interface{}{ "samus", "jigglypuff", "r.o.b.", "mega man", "yoshi" }
+    list   := NewLinkedListOf(values)
+
+    for i, expected := range values {
+        actual, err := list.ValueWithIndex(i)
+
+        assertError(t, err, nil)
+        if actual != expected {
+            t.Errorf("expected value '%v' at index %d, but found '%v'", expected, i, actual)
+        }
+    }
+
+    if _, err := list.ValueWithIndex(list.Size()); err == nil {
+        t.Errorf("expected error for index %d", list.Size())
+    }
+}
+
+func TestLinkedList_Remove(t *testing.T) {
+    elements := []element{
+        { value: "piranha plant", position: 0 },
+        { value: "samus",         position: 1 },
+        { value: "jigglypuff",    position: 2 },
+        { value: "r.o.b.",        position: 3 },
+        { value: "mega man",      position: 4 },
+        { value: "yoshi",         position: 5 },
+    }
+
+    t.Run("Remove", func(t *testing.T) {
+        list    := NewLinkedListOf(elements)
+        element := elements[3]
+
+        if !list.Remove(element) {
+            t.Error("expected result to be true")
+        }
+
+        if list.Remove(element) {
+            t.Error("expected result to be false")
+        }
+
+        assertSize(t, list, 5)
+        assertContains(t, list, element, false)
+    })
+
+    t.Run("RemoveFirst", func(t *testing.T) {
+        list    := NewLinkedListOf(elements)
+        element := list.RemoveFirst()
+
+        if element == nil {
+            t.Error("expected element after remove but was nil")
+        }
+
+        assertSize(t, list, 5)
+        assertContains(t, list, element, false)
+        assertIndex(t, list, elements[1], 0)
+    })
+
+    t.Run("RemoveLast", func(t *testing.T) {
+        list    := NewLinkedListOf(elements)
+        element := list.RemoveLast()
+
+        if element == nil {
+            t.Error("expected element after remove but was nil")
+        }
+
+        assertSize(t, list, 5)
+        assertContains(t, list, element, false)
+    })
+
+    t.Run("RemoveWithIndex", func(t *testing.T) {
+        list         := NewLinkedListOf(elements)
+        element, err := list.RemoveWithIndex(4)
+
+        assertError(t, err, nil)
+        assertSize(t, list, 5)
+        assertContains(t, list, element, false)
+        assertIndex(t, list, elements[5], 4)
+    })
+
+    t.Run("RemoveFirst,RemoveLast,Empty", func(t *testing.T) {
+        list := NewLinkedList()
+
+        if list.RemoveFirst() != nil {
+            t.Error("expected nil from RemoveFirst on empty list")
+        }
+
+        if list.RemoveLast() != nil {
+            t.Error("expected nil from RemoveLast on empty list")
+        }
+    })
+
+    t.Run("Clear", func(t *testing.T) {
+        list := NewLinkedListOf(elements)
+
+        assertSize(t, list, 6)
+
+        if list.IsEmpty() {
+            t.Error("expected result to be false")
+        }
+
+        list.Clear()
+
+        if !list.IsEmpty() {
+            t.Error("expected result to be true")
+        }
+
+        _ = list.Add(elements[0])
+        assertSize(t, list, 1)
+    })
+}
+
+func TestLinkedList_Functional(t *testing.T) {
+    elements := []interface{}{
+        "piranha plant",
+        element{ value: "samus", position: 1 },
+        "jigglypuff",
+        nil,
+        element{ value: "mega man", position: 4 },
+        element{ value: "yoshi", position: 5 },
+    }
+
+    t.Run("Filter,Map,ForEach", func(t *testing.T) {
+        byElementType := func(v interface{}) bool {
+            switch v.(type) {
+            case element:
+                return true
+            default:
+                return false
+            }
+        }
+
+        valueToUpperCase := func(v interface{}) interface{} {
+            e, _ := v.(element)
+            e.value = strings.ToUpper(e.value)
+            return e
+        }
+
+        list := NewLinkedList()
+        collect := func(v interface{}) {
+            _ = list.Add(v)
+        }
+
+        NewLinkedListOf(elements).
+            Filter(byElementType).
+            Map(valueToUpperCase).
+            ForEach(collect)
+
+        assertSize(t, list, 3)
+        assertContains(t, list, element{ value: "SAMUS", position: 1 }, true)
+        assertContains(t, list, element{ value: "MEGA MAN", position: 4 }, true)
+        assertContains(t, list, element{ value: "YOSHI", position: 5 }, true)
+    })
+
+    t.Run("String", func(t *testing.T) {
+        list := NewLinkedListOf([]interface{}{ "a", 1, "b", 2 })
+
+        if actual := fmt.Sprintf("%s", list); actual != "[a, 1, b, 2]" {
+            t.Errorf("expected content of '[a, 1, b, 2]', but found '%s'", actual)
+        }
+    })
+}
@@ -1,6 +1,14 @@
 package list
 
-import "github.com/2speed/go-collection"
+import (
+    "fmt"
+    "reflect"
+    "strconv"
+    "strings"
+
+    "github.com/2speed/go-collection"
+    "github.com/pkg/errors"
+)
 
 // List defines the behavior for a container the represents a Collection of elements that are accessed via their
 // position much like that of an array or slice. Unlike an array or slice however, elements of a list are more "generic"
@@ -18,6 +26,11 @@ type List interface {
     // non-nil for bounded List implementations that have reached capacity and cannot hold any further elements.
     AddLast(element interface{}) error
 
+    // AddValues inserts the provided elements at the end of the List in the order they are provided. The returned error
+    // will be non-nil for bounded List implementations that cannot hold all of the elements, in which case no elements
+    // are inserted.
+    AddValues(elements ...interface{}) error
+
     // AddWithIndex inserts the provided element into the List specified by index. The position of the elements that
     // were at positions index to List.Size() - 1 increase by one. The returned error will be non-nil if the provided
     // index is outside the current bounds of the List (index < 0 || index > List.Size() - 1).
@@ -45,13 +58,211 @@ type List interface {
     // non-nil if the provided index is outside the bounds of the List (index < 0 || index > List.Size() - 1).
     RemoveWithIndex(index int) (interface{}, error)
 
+    // RemoveFirstMatch removes the first element in iteration order that matches the given predicate from the List and
+    // returns it, along with true if such an element was found. The positions of the elements after it are decremented
+    // by 1. If no element matches the predicate, the return values will be nil and false, and the List is unchanged.
+    RemoveFirstMatch(predicate func(element interface{}) bool) (interface{}, bool)
+
+    // RemoveRange removes the elements of the List at positions from (inclusive) to to (exclusive). The positions of the
+    // elements originally at positions to to List.Size() - 1 are decremented by to - from. An empty range
+    // (from == to) removes no elements. The returned error will be non-nil if the provided range is outside the current
+    // bounds of the List (from < 0 || from > to || to > List.Size()).
+    RemoveRange(from int, to int) error
+
+    // Splice removes the elements of the List at positions from (inclusive) to to (exclusive) as with RemoveRange, and
+    // returns them in order as a new List of the same kind. An empty range (from == to) removes no elements and returns
+    // an empty List. The returned error will be non-nil if the provided range is outside the current bounds of the List
+    // (from < 0 || from > to || to > List.Size()), in which case the returned List will be nil.
+    Splice(from int, to int) (List, error)
+
+    // Compact removes every nil element from the List in a single pass, and returns the number of elements removed. The
+    // relative order of the remaining elements is unchanged.
+    Compact() int
+
+    // InsertSorted inserts the provided element into the List after any elements that are not greater than it according
+    // to the provided comparator, which returns true if a is less than b, and returns the position where the element
+    // was placed. The List is assumed to already be sorted by the comparator, otherwise the position is unspecified.
+    // The returned position will be collection.ElementNotFound for List implementations that cannot hold the element.
+    InsertSorted(element interface{}, less func(a, b interface{}) bool) int
+
+    // Fill replaces every element of the List with the provided value. The size of the List is unchanged.
+    Fill(value interface{})
+
+    // FillRange replaces the elements of the List at positions from (inclusive) to to (exclusive) with the provided
+    // value. The size of the List is unchanged. The returned error will be non-nil if the provided range is outside the
+    // current bounds of the List (from < 0 || from > to || to > List.Size()).
+    FillRange(from int, to int, value interface{}) error
+
+    // Apply replaces each element of the List with the result of applying the provided function to it, in iteration
+    // order. Unlike Map, which returns a new List, Apply mutates the List in place, so no new List is allocated. The size
+    // of the List is unchanged.
+    Apply(mapper func(element interface{}) interface{})
+
+    // ContainsAllOf returns a map from each of the provided elements to true if it exists in the List, otherwise false.
+    // Elements that are not hashable (see collection.IsHashable) cannot be keys of the map, and are omitted.
+    ContainsAllOf(elements []interface{}) map[interface{}]bool
+
     // Filter returns a new List consisting of the elements of this List that match the given predicate.
     Filter(predicate func(element interface{}) bool) List
 
+    // FilterIndexed returns a new List consisting of the elements of this List that match the given predicate, which is
+    // provided the position of each element in this List along with the element.
+    FilterIndexed(predicate func(index int, element interface{}) bool) List
+
+    // Find returns the first element in iteration order that matches the given predicate, and true if such an element
+    // exists. Unlike Filter, the traversal stops at the first match. If no element matches the predicate, the return
+    // values will be nil and false.
+    Find(predicate func(element interface{}) bool) (interface{}, bool)
+
+    // Any returns true if at least one element of the List matches the given predicate, otherwise false is returned.
+    // The traversal stops at the first match, and an empty List has no matching elements.
+    Any(predicate func(element interface{}) bool) bool
+
+    // All returns true if every element of the List matches the given predicate, otherwise false is returned. The
+    // traversal stops at the first element that does not match, and every element of an empty List matches (i.e. All is
+    // vacuously true).
+    All(predicate func(element interface{}) bool) bool
+
     // Map returns a new List containing the resulting elements of applying the given function to the elements of this
     // List.
     Map(mapper func(element interface{}) interface{}) List
 
+    // MapError returns a new List containing the resulting elements of applying the given function, which may fail, to
+    // the elements of this List. The elements are mapped in iteration order, and mapping stops at the first error
+    // returned by the function. In that case the returned error is the error of the function, and the returned List is
+    // a partial result containing the elements mapped before the failure.
+    MapError(mapper func(element interface{}) (interface{}, error)) (List, error)
+
     // ForEach performs the provided consumer function for each element of the List.
     ForEach(consumer func(element interface{}))
+
+    // StringQuoted returns a string representation of the List in which string elements are quoted (with Go escape
+    // sequences) and nil elements are rendered as nil, so that elements containing separators (e.g. ", ") remain
+    // distinguishable. Nested Lists are rendered with StringQuoted as well.
+    StringQuoted() string
+
+    // Stream returns a channel over which the elements of the List are sent in iteration order, which is closed once
+    // every element has been sent. Unlike Values, the elements are not collected into a slice. The producer of the
+    // elements is blocked until they are received, so the channel should be drained (e.g. with range); to stop early,
+    // use collection.Stream with a done channel instead. The List must not be modified until the channel is closed.
+    Stream() <-chan interface{}
+
+    // ReverseIterator returns an Iterator positioned after the last element of the List, which traverses the elements
+    // of the List from the last to the first.
+    ReverseIterator() collection.Iterator
+
+    // EqualsIgnoreOrder returns true if the provided List contains the same elements as this List with the same number
+    // of occurrences, regardless of their positions (i.e. the Lists are compared as multisets, see collection.Equals).
+    // A nil List is not equal to any List.
+    EqualsIgnoreOrder(other List) bool
+
+    // ToSlice copies the elements of the List into the slice pointed to by the provided destination (e.g. *[]string),
+    // replacing its contents. The returned error will be non-nil if the destination is not a non-nil pointer to a
+    // slice, or if any element is not assignable to the element type of the slice, in which case the destination is
+    // left unchanged.
+    ToSlice(dst interface{}) error
+
+    // CopyTo copies the elements of the List in order into the provided slice, and returns the number of elements
+    // copied, which is the minimum of the length of the slice and List.Size(). Elements of the slice beyond those
+    // copied are left unchanged. Unlike Values, no slice is allocated.
+    CopyTo(dst []interface{}) int
+}
+
+// SliceBacked defines the behavior for a List whose elements are maintained by a slice that can be accessed directly
+// (e.g. ArrayList). Callers can type assert a List to SliceBacked to avoid the copy made by Values on read-mostly hot
+// paths.
+type SliceBacked interface {
+    List
+
+    // UnsafeSlice returns the slice backing the List without copying it. The returned slice aliases the internal state
+    // of the List: assigning to its elements modifies the List, and it is only valid until the next modification of the
+    // List, which may reallocate or overwrite it. Values should be used unless the caller owns the List and no longer
+    // holds the slice when the List is modified.
+    UnsafeSlice() []interface{}
+}
+
+// Min returns the least element of the provided List according to the provided comparator, which returns true if a is
+// less than b. If several elements are equivalent to the least element, the first of them is returned. The returned
+// error will be collection.ErrorEmpty if the List is empty.
+func Min(l List, less func(a, b interface{}) bool) (interface{}, error) {
+    return extreme(l, less)
+}
+
+// Max returns the greatest element of the provided List according to the provided comparator, which returns true if a
+// is less than b. If several elements are equivalent to the greatest element, the first of them is returned. The
+// returned error will be collection.ErrorEmpty if the List is empty.
+func Max(l List, less func(a, b interface{}) bool) (interface{}, error) {
+    return extreme(l, func(a, b interface{}) bool { return less(b, a) })
+}
+
+// extreme returns the first element of the provided List that no other element is before according to the provided
+// comparator, in a single scan of the List.
+func extreme(l List, before func(a, b interface{}) bool) (interface{}, error) {
+    if l == nil || l.IsEmpty() {
+        return nil, collection.ErrorEmpty
+    }
+
+    var result interface{}
+    first := true
+    l.ForEach(func(element interface{}) {
+        if first || before(element, result) {
+            result = element
+            first  = false
+        }
+    })
+
+    return result, nil
+}
+
+// quotedStringer is implemented by Lists that can render their elements with StringQuoted.
+type quotedStringer interface {
+    StringQuoted() string
+}
+
+// stringQuoted returns a string representation of the provided elements in which string elements are quoted, nil
+// elements are rendered as nil, and nested Lists are rendered with StringQuoted.
+func stringQuoted(elements []interface{}) string {
+    values := make([]string, 0, len(elements))
+    for _, element := range elements {
+        switch e := element.(type) {
+        case nil:
+            values = append(values, "nil")
+        case string:
+            values = append(values, strconv.Quote(e))
+        case quotedStringer:
+            values = append(values, e.StringQuoted())
+        default:
+            values = append(values, fmt.Sprintf("%v", e))
+        }
+    }
+
+    return "[" + strings.Join(values, ", ") + "]"
+}
+
+// toSlice copies the provided elements into the slice pointed to by the provided destination.
+func toSlice(elements []interface{}, dst interface{}) error {
+    pointer := reflect.ValueOf(dst)
+    if pointer.Kind() != reflect.Ptr || pointer.IsNil() || pointer.Elem().Kind() != reflect.Slice {
+        return errors.Errorf("destination must be a non-nil pointer to a slice [destination type = %T]", dst)
+    }
+
+    elementType := pointer.Elem().Type().Elem()
+    slice       := reflect.MakeSlice(pointer.Elem().Type(), len(elements), len(elements))
+    for i, element := range elements {
+        value := reflect.ValueOf(element)
+        if element == nil {
+            switch elementType.Kind() {
+            case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+                continue
+            }
+        } else if value.Type().AssignableTo(elementType) {
+            slice.Index(i).Set(value)
+            continue
+        }
+
+        return errors.Errorf("element is not assignable to destination [index = %v, element type = %T, destination element type = %v]", i, element, elementType)
+    }
+    pointer.Elem().Set(slice)
+
+    return nil
 }
\ No newline at end of file
@@ -0,0 +1,425 @@
+package list
+
+import (
+    "fmt"
+    "reflect"
+    "testing"
+
+    "github.com/2speed/go-collection"
+)
+
+func TestMinMax(t *testing.T) {
+    byInt    := func(a, b interface{}) bool { return a.(int) < b.(int) }
+    byString := func(a, b interface{}) bool { return a.(string) < b.(string) }
+    byLength := func(a, b interface{}) bool { return len(a.(string)) < len(b.(string)) }
+
+    for _, c := range []struct {
+        name     string
+        list     List
+        less     func(a, b interface{}) bool
+        min, max interface{}
+    }{
+        { name: "Int",         list: NewArrayListOf([]interface{}{ 3, -1, 7, 0, 7 }),                     less: byInt,    min: -1,      max: 7 },
+        { name: "Single",      list: NewLinkedListOf([]interface{}{ 42 }),                                less: byInt,    min: 42,      max: 42 },
+        { name: "String",      list: NewArrayListOf([]interface{}{ "samus", "luffy", "yoshi", "kirby" }), less: byString, min: "kirby", max: "yoshi" },
+        { name: "FirstOfTies", list: NewLinkedListOf([]interface{}{ "ab", "cd", "e", "f" }),              less: byLength, min: "e",     max: "ab" },
+    } {
+        if actual, err := Min(c.list, c.less); err != nil || actual != c.min {
+            t.Errorf("%s: expected min '%v', but found '%v' (%v)", c.name, c.min, actual, err)
+        }
+
+        if actual, err := Max(c.list, c.less); err != nil || actual != c.max {
+            t.Errorf("%s: expected max '%v', but found '%v' (%v)", c.name, c.max, actual, err)
+        }
+    }
+
+    t.Run("Empty", func(t *testing.T) {
+        if _, err := Min(NewArrayList(), byInt); err != collection.ErrorEmpty {
+            t.Errorf("expected '%v', but found '%v'", collection.ErrorEmpty, err)
+        }
+
+        if _, err := Max(NewLinkedList(), byInt); err != collection.ErrorEmpty {
+            t.Errorf("expected '%v', but found '%v'", collection.ErrorEmpty, err)
+        }
+    })
+}
+
+func TestFunctional_Type(t *testing.T) {
+    elements := []interface{}{ 1, 2, 3, 4 }
+    newList  := func(list List) List {
+        _ = list.AddAll(NewArrayListOf(elements))
+        return list
+    }
+
+    for _, c := range []struct {
+        list     List
+        expected string
+    }{
+        { list: newList(NewArrayList()),                       expected: "*list.arrayList" },
+        { list: newList(NewLinkedList()),                      expected: "*list.linkedList" },
+        { list: newList(NewCircularList(4)),                   expected: "*list.circularList" },
+        { list: newList(NewIndexedArrayList()),                expected: "*list.indexedArrayList" },
+        { list: newList(NewCopyOnWriteList()),                 expected: "*list.copyOnWriteList" },
+        { list: NewSynchronizedList(newList(NewLinkedList())), expected: "*list.synchronizedList" },
+        { list: NewImmutableList(newList(NewLinkedList())),    expected: "*list.arrayList" },
+    } {
+        source  := fmt.Sprintf("%T", c.list)
+        evens   := c.list.Filter(func(element interface{}) bool { return element.(int) % 2 == 0 })
+        odds    := c.list.FilterIndexed(func(index int, element interface{}) bool { return index % 2 == 0 })
+        doubled := c.list.Map(func(element interface{}) interface{} { return element.(int) * 2 })
+
+        for _, result := range []List{ evens, odds, doubled } {
+            if actual := fmt.Sprintf("%T", result); actual != c.expected {
+                t.Errorf("%s: expected result of type '%s', but found '%s'", source, c.expected, actual)
+            }
+        }
+
+        assertContentEquals(t, evens, "[2, 4]")
+        assertContentEquals(t, odds, "[1, 3]")
+        assertContentEquals(t, doubled, "[2, 4, 6, 8]")
+    }
+}
+
+func TestCompact(t *testing.T) {
+    values  := []interface{}{ nil, "samus", nil, nil, "luffy", "yoshi", nil }
+    newList := func(list List) List {
+        _ = list.AddValues(values...)
+        return list
+    }
+
+    wrapped := NewCircularListWithOverwrite(len(values))
+    _ = wrapped.AddValues("mario", "kirby", "link")
+
+    for _, list := range []List{
+        newList(NewArrayList()),
+        newList(NewLinkedList()),
+        newList(wrapped),
+        newList(NewIndexedArrayList()),
+        newList(NewCopyOnWriteList()),
+        NewSynchronizedList(newList(NewArrayList())),
+    } {
+        name := fmt.Sprintf("%T", list)
+
+        if removed := list.Compact(); removed != 4 {
+            t.Errorf("%s: expected '4' elements to be removed, but found '%d'", name, removed)
+        }
+        assertContentEquals(t, list, "[samus, luffy, yoshi]")
+
+        if index, err := list.IndexOf("yoshi"); err != nil || index != 2 {
+            t.Errorf("%s: expected index of '2', but found '%d' (%v)", name, index, err)
+        }
+
+        if removed := list.Compact(); removed != 0 {
+            t.Errorf("%s: expected no further elements to be removed, but found '%d'", name, removed)
+        }
+    }
+
+    immutable := NewImmutableList(NewArrayListOf(values))
+    if removed := immutable.Compact(); removed != 0 || immutable.Size() != len(values) {
+        t.Errorf("expected the ImmutableList to be unchanged, but found '%d' removed", removed)
+    }
+}
+
+func TestAddAll_Self(t *testing.T) {
+    values  := []interface{}{ "samus", "luffy", "yoshi" }
+    newList := func(list List) List {
+        _ = list.AddValues(values...)
+        return list
+    }
+
+    for _, list := range []List{
+        newList(NewArrayList()),
+        newList(NewLinkedList()),
+        newList(NewCircularList(2 * len(values))),
+        newList(NewIndexedArrayList()),
+        newList(NewCopyOnWriteList()),
+        NewSynchronizedList(newList(NewArrayList())),
+    } {
+        name := fmt.Sprintf("%T", list)
+
+        assertError(t, list.AddAll(list), nil)
+        if list.Size() != 2 * len(values) {
+            t.Errorf("%s: expected size of '%d', but found '%d'", name, 2 * len(values), list.Size())
+        }
+        assertContentEquals(t, list, "[samus, luffy, yoshi, samus, luffy, yoshi]")
+
+        if index, err := list.IndexOf("luffy"); err != nil || index != 1 {
+            t.Errorf("%s: expected index of '1', but found '%d' (%v)", name, index, err)
+        }
+    }
+
+    t.Run("Capacity", func(t *testing.T) {
+        list := newList(NewCircularList(2 * len(values) - 1))
+
+        assertError(t, list.AddAll(list), collection.ErrorCapacityReached)
+        assertContentEquals(t, list, "[samus, luffy, yoshi]")
+    })
+
+    t.Run("SortedList", func(t *testing.T) {
+        list := NewSortedList(func(a, b interface{}) bool { return a.(string) < b.(string) })
+        _ = list.AddAll(NewArrayListOf(values))
+
+        assertError(t, list.AddAll(list), nil)
+        assertContentEquals(t, list, "[luffy, luffy, samus, samus, yoshi, yoshi]")
+    })
+}
+
+func TestEqualsIgnoreOrder(t *testing.T) {
+    values := []interface{}{ "samus", []int{ 1, 2 }, "luffy", "samus", nil }
+
+    for _, list := range []List{
+        NewArrayListOf(values),
+        NewLinkedListOf(values),
+        NewIndexedArrayList(),
+        NewImmutableList(NewArrayListOf(values)),
+        NewSynchronizedList(NewArrayListOf(values)),
+    } {
+        name := fmt.Sprintf("%T", list)
+        if list.IsEmpty() {
+            _ = list.AddValues(values...)
+        }
+
+        for _, c := range []struct {
+            other    List
+            expected bool
+        }{
+            { other: NewArrayListOf([]interface{}{ nil, "samus", "luffy", []int{ 1, 2 }, "samus" }), expected: true },
+            { other: NewLinkedListOf([]interface{}{ "samus", "samus", nil, []int{ 1, 2 }, "luffy" }), expected: true },
+            { other: NewArrayListOf([]interface{}{ "samus", "luffy", "luffy", []int{ 1, 2 }, nil }),  expected: false },
+            { other: NewArrayListOf([]interface{}{ "samus", "luffy", "samus", []int{ 2, 1 }, nil }),  expected: false },
+            { other: NewArrayListOf([]interface{}{ "samus", "luffy", "samus", []int{ 1, 2 } }),       expected: false },
+            { other: nil,                                                                             expected: false },
+        } {
+            if actual := list.EqualsIgnoreOrder(c.other); actual != c.expected {
+                t.Errorf("%s: expected EqualsIgnoreOrder(%v) to be '%t', but found '%t'", name, c.other, c.expected, actual)
+            }
+        }
+    }
+}
+
+func TestAnyAll(t *testing.T) {
+    isEven := func(element interface{}) bool { return element.(int) % 2 == 0 }
+
+    for _, newList := range []func() List{
+        NewArrayList,
+        NewLinkedList,
+        func() List { return NewCircularList(4) },
+        NewIndexedArrayList,
+        NewCopyOnWriteList,
+        func() List { return NewSynchronizedList(NewArrayList()) },
+    } {
+        name := fmt.Sprintf("%T", newList())
+
+        for _, c := range []struct {
+            values   []interface{}
+            any, all bool
+        }{
+            { values: []interface{}{},              any: false, all: true },
+            { values: []interface{}{ 2, 4, 6, 8 },  any: true,  all: true },
+            { values: []interface{}{ 1, 3, 5, 7 },  any: false, all: false },
+            { values: []interface{}{ 1, 3, 4, 7 },  any: true,  all: false },
+        } {
+            list := newList()
+            _ = list.AddValues(c.values...)
+
+            if actual := list.Any(isEven); actual != c.any {
+                t.Errorf("%s: expected Any of '%v' to be '%t', but found '%t'", name, c.values, c.any, actual)
+            }
+            if actual := list.All(isEven); actual != c.all {
+                t.Errorf("%s: expected All of '%v' to be '%t', but found '%t'", name, c.values, c.all, actual)
+            }
+        }
+    }
+
+    visited := 0
+    NewArrayListOf([]interface{}{ 1, 2, 3, 4 }).All(func(element interface{}) bool {
+        visited++
+        return element.(int) < 2
+    })
+    if visited != 2 {
+        t.Errorf("expected All to stop after '2' elements, but visited '%d'", visited)
+    }
+}
+
+func TestRemoveFirstMatch(t *testing.T) {
+    hasValue := func(value string) func(element interface{}) bool {
+        return func(e interface{}) bool { return e.(element).value == value }
+    }
+
+    for _, newList := range []func() List{
+        NewArrayList,
+        NewLinkedList,
+        func() List { return NewCircularList(4) },
+        NewIndexedArrayList,
+        NewCopyOnWriteList,
+        func() List { return NewSynchronizedList(NewArrayList()) },
+    } {
+        name := fmt.Sprintf("%T", newList())
+        list := newList()
+        _ = list.AddValues(
+            element{ value: "samus", position: 0 },
+            element{ value: "yoshi", position: 1 },
+            element{ value: "kirby", position: 2 },
+            element{ value: "yoshi", position: 3 },
+        )
+
+        removed, found := list.RemoveFirstMatch(hasValue("yoshi"))
+        if !found || removed != (element{ value: "yoshi", position: 1 }) {
+            t.Errorf("%s: expected to remove '{yoshi 1}', but found '%v' (found: %t)", name, removed, found)
+        }
+        assertSize(t, list, 3)
+
+        if actual, _ := list.ValueWithIndex(1); actual != (element{ value: "kirby", position: 2 }) {
+            t.Errorf("%s: expected '{kirby 2}' at index '1', but found '%v'", name, actual)
+        }
+        if index, _ := list.IndexOf(element{ value: "yoshi", position: 3 }); index != 2 {
+            t.Errorf("%s: expected '{yoshi 3}' at index '2', but found '%d'", name, index)
+        }
+
+        if removed, found := list.RemoveFirstMatch(hasValue("luffy")); found || removed != nil {
+            t.Errorf("%s: expected no match, but removed '%v'", name, removed)
+        }
+        assertSize(t, list, 3)
+    }
+
+    t.Run("Observable", func(t *testing.T) {
+        var events []MutationEvent
+        list, observe := NewObservableList(NewArrayListOf([]interface{}{ 1, 2, 3 }))
+        observe(func(event MutationEvent) { events = append(events, event) })
+
+        list.RemoveFirstMatch(func(element interface{}) bool { return element.(int) > 1 })
+        list.RemoveFirstMatch(func(element interface{}) bool { return element.(int) > 5 })
+
+        if len(events) != 1 || events[0] != (MutationEvent{ Operation: Removed, Element: 2 }) {
+            t.Errorf("expected a single Removed event for '2', but found '%v'", events)
+        }
+    })
+
+    if _, found := NewImmutableList(NewArrayListOf([]interface{}{ 1 })).RemoveFirstMatch(
+        func(element interface{}) bool { return true }); found {
+        t.Errorf("expected an ImmutableList to remove nothing")
+    }
+}
+
+func TestCopyTo(t *testing.T) {
+    for _, newList := range []func() List{
+        NewArrayList,
+        NewLinkedList,
+        func() List { return NewCircularList(4) },
+        NewIndexedArrayList,
+        NewCopyOnWriteList,
+        func() List { return NewSynchronizedList(NewArrayList()) },
+        func() List { return NewImmutableList(NewArrayListOf([]interface{}{ 1, 2, 3, 4 })) },
+    } {
+        name := fmt.Sprintf("%T", newList())
+        list := newList()
+        _ = list.AddValues(1, 2, 3, 4)
+
+        for _, c := range []struct {
+            length   int
+            copied   int
+            expected []interface{}
+        }{
+            { length: 2, copied: 2, expected: []interface{}{ 1, 2 } },
+            { length: 4, copied: 4, expected: []interface{}{ 1, 2, 3, 4 } },
+            { length: 6, copied: 4, expected: []interface{}{ 1, 2, 3, 4, "x", "x" } },
+        } {
+            dst := make([]interface{}, c.length)
+            for i := range dst {
+                dst[i] = "x"
+            }
+
+            if copied := list.CopyTo(dst); copied != c.copied || !reflect.DeepEqual(dst, c.expected) {
+                t.Errorf("%s: expected '%d' elements copied as '%v', but found '%d' as '%v'", name, c.copied, c.expected,
+                    copied, dst)
+            }
+        }
+    }
+
+    circular := NewCircularList(4)
+    _ = circular.AddValues(0, 1, 2, 3)
+    circular.RemoveFirst()
+    _ = circular.Add(4)
+
+    dst := make([]interface{}, 4)
+    if copied := circular.CopyTo(dst); copied != 4 || !reflect.DeepEqual(dst, []interface{}{ 1, 2, 3, 4 }) {
+        t.Errorf("expected the wrapped elements to be copied as '[1 2 3 4]', but found '%v'", dst)
+    }
+
+    if copied := NewArrayList().CopyTo(make([]interface{}, 2)); copied != 0 {
+        t.Errorf("expected no elements copied from an empty list, but found '%d'", copied)
+    }
+}
+
+func TestSplice(t *testing.T) {
+    for _, newList := range []func() List{
+        NewArrayList,
+        NewLinkedList,
+        func() List { return NewCircularList(8) },
+        NewIndexedArrayList,
+        NewCopyOnWriteList,
+        func() List { return NewSynchronizedList(NewArrayList()) },
+    } {
+        name := fmt.Sprintf("%T", newList())
+
+        for _, c := range []struct {
+            from, to         int
+            spliced, remains []interface{}
+        }{
+            { from: 1, to: 4, spliced: []interface{}{ 1, 2, 3 },          remains: []interface{}{ 0, 4, 5 } },
+            { from: 0, to: 2, spliced: []interface{}{ 0, 1 },             remains: []interface{}{ 2, 3, 4, 5 } },
+            { from: 4, to: 6, spliced: []interface{}{ 4, 5 },             remains: []interface{}{ 0, 1, 2, 3 } },
+            { from: 0, to: 6, spliced: []interface{}{ 0, 1, 2, 3, 4, 5 }, remains: []interface{}{} },
+            { from: 3, to: 3, spliced: []interface{}{},                   remains: []interface{}{ 0, 1, 2, 3, 4, 5 } },
+        } {
+            list := newList()
+            _ = list.AddValues(0, 1, 2, 3, 4, 5)
+
+            spliced, err := list.Splice(c.from, c.to)
+            assertError(t, err, nil)
+
+            if !reflect.DeepEqual(spliced.Values(), c.spliced) || !reflect.DeepEqual(list.Values(), c.remains) {
+                t.Errorf("%s: expected a splice of [%d, %d) to partition into '%v' and '%v', but found '%v' and '%v'",
+                    name, c.from, c.to, c.spliced, c.remains, spliced.Values(), list.Values())
+            }
+
+            if actual, expected := fmt.Sprintf("%T", spliced), name; actual != expected {
+                t.Errorf("%s: expected the spliced list to be a '%s', but found '%s'", name, expected, actual)
+            }
+
+            if n := len(c.remains); n > 0 {
+                if index, _ := list.IndexOf(c.remains[n - 1]); index != n - 1 {
+                    t.Errorf("%s: expected '%v' at index '%d', but found '%d'", name, c.remains[n - 1], n - 1, index)
+                }
+            }
+        }
+
+        list := newList()
+        _ = list.AddValues(0, 1, 2)
+        for _, r := range [][2]int{ { -1, 1 }, { 2, 1 }, { 1, 4 } } {
+            if spliced, err := list.Splice(r[0], r[1]); err == nil || spliced != nil {
+                t.Errorf("%s: expected error when splicing [%d, %d)", name, r[0], r[1])
+            }
+        }
+        assertSize(t, list, 3)
+    }
+
+    t.Run("Observable", func(t *testing.T) {
+        var events []MutationEvent
+        list, observe := NewObservableList(NewArrayListOf([]interface{}{ 1, 2, 3 }))
+        observe(func(event MutationEvent) { events = append(events, event) })
+
+        spliced, err := list.Splice(0, 2)
+        assertError(t, err, nil)
+        assertSize(t, spliced, 2)
+
+        expected := []MutationEvent{ { Operation: Removed, Element: 1 }, { Operation: Removed, Element: 2 } }
+        if !reflect.DeepEqual(events, expected) {
+            t.Errorf("expected events '%v', but found '%v'", expected, events)
+        }
+    })
+
+    if _, err := NewImmutableList(NewArrayListOf([]interface{}{ 1 })).Splice(0, 1); err != collection.ErrorImmutable {
+        t.Errorf("expected '%v' when splicing an ImmutableList, but found '%v'", collection.ErrorImmutable, err)
+    }
+}
@@ -0,0 +1,488 @@
+package list
+
+import (
+    "fmt"
+
+    "github.com/2speed/go-collection"
+)
+
+// MutationOperation identifies the kind of modification described by a MutationEvent.
+type MutationOperation int
+
+const (
+    // Added is the operation of an element inserted into an ObservableList.
+    Added MutationOperation = iota + 1
+
+    // Removed is the operation of an element removed from an ObservableList.
+    Removed
+
+    // Replaced is the operation of an element that replaced another element of an ObservableList (e.g. via Fill).
+    Replaced
+
+    // Cleared is the operation of all elements being removed from an ObservableList by Clear. The element of the event
+    // is nil.
+    Cleared
+)
+
+// String returns the name of the MutationOperation.
+func (o MutationOperation) String() string {
+    switch o {
+    case Added:
+        return "Added"
+    case Removed:
+        return "Removed"
+    case Replaced:
+        return "Replaced"
+    case Cleared:
+        return "Cleared"
+    default:
+        return fmt.Sprintf("MutationOperation(%d)", int(o))
+    }
+}
+
+// MutationEvent describes a modification of an ObservableList, consisting of the operation and the element it applied
+// to.
+type MutationEvent struct {
+    Operation MutationOperation
+    Element   interface{}
+}
+
+// ObservableList is a List decorator that notifies the registered observers of each modification of a delegate List.
+// Each mutator notifies the observers after the modification has been applied to the delegate, with one event for each
+// element inserted, removed, or replaced, in the order the elements were modified. Mutators that fail, or that do not
+// modify the delegate, notify no observers. Elements discarded by an overwriting CircularList to make room for inserted
+// elements are not reported. When no observers are registered, the mutators only check the number of observers before
+// delegating. The Lists returned by Filter and Map are not observable. ObservableList does not make any guarantees for
+// concurrent access.
+type observableList struct {
+    delegate  List
+    observers []func(event MutationEvent)
+}
+
+// NewObservableList creates a new ObservableList that reports the modifications of the provided List, along with the
+// function that registers an observer of the ObservableList. Observers are notified in the order they were registered.
+// The provided List should not be modified directly after it has been wrapped, since its modifications would not be
+// reported.
+func NewObservableList(delegate List) (List, func(observer func(event MutationEvent))) {
+    l := &observableList{ delegate: delegate }
+
+    return l, l.observe
+}
+
+// Add inserts the provided element into the ObservableList, and notifies the observers that it was added.
+func (l *observableList) Add(element interface{}) error {
+    if err := l.delegate.Add(element); err != nil {
+        return err
+    }
+    l.notify(Added, element)
+
+    return nil
+}
+
+// AddAll inserts all elements from the provided Collection into the ObservableList, and notifies the observers that
+// each of them was added.
+func (l *observableList) AddAll(c collection.Collection) error {
+    if c == nil {
+        return nil
+    }
+
+    return l.AddValues(c.Values()...)
+}
+
+// AddFirst inserts the provided element at the front (index == 0) of the ObservableList, and notifies the observers
+// that it was added.
+func (l *observableList) AddFirst(element interface{}) error {
+    if err := l.delegate.AddFirst(element); err != nil {
+        return err
+    }
+    l.notify(Added, element)
+
+    return nil
+}
+
+// AddLast inserts the provided element at the end of the ObservableList (index == ObservableList.Size()), and notifies
+// the observers that it was added.
+func (l *observableList) AddLast(element interface{}) error {
+    if err := l.delegate.AddLast(element); err != nil {
+        return err
+    }
+    l.notify(Added, element)
+
+    return nil
+}
+
+// AddValues inserts the provided elements at the end of the ObservableList in the order they are provided, and
+// notifies the observers that each of them was added.
+func (l *observableList) AddValues(elements ...interface{}) error {
+    if err := l.delegate.AddValues(elements...); err != nil {
+        return err
+    }
+    l.notifyAll(Added, elements)
+
+    return nil
+}
+
+// AddWithIndex inserts the provided element into the ObservableList specified by index, and notifies the observers
+// that it was added. The returned error will be non-nil if the provided index is outside the current bounds of the
+// ObservableList (index < 0 || index > ObservableList.Size()).
+func (l *observableList) AddWithIndex(index int, element interface{}) error {
+    if err := l.delegate.AddWithIndex(index, element); err != nil {
+        return err
+    }
+    l.notify(Added, element)
+
+    return nil
+}
+
+// ValueWithIndex returns the element at the position specified by the provided index.
+func (l *observableList) ValueWithIndex(index int) (interface{}, error) {
+    return l.delegate.ValueWithIndex(index)
+}
+
+// IndexOf returns the position of the first occurrence (if any) of an element equivalent to the provided element.
+func (l *observableList) IndexOf(element interface{}) (int, error) {
+    return l.delegate.IndexOf(element)
+}
+
+// Remove removes the first occurrence (if any) of an element equivalent to the provided element, and notifies the
+// observers that it was removed.
+func (l *observableList) Remove(element interface{}) bool {
+    if !l.delegate.Remove(element) {
+        return false
+    }
+    l.notify(Removed, element)
+
+    return true
+}
+
+// RemoveAll removes every occurrence of each element of the provided collection from the ObservableList, and returns
+// the number of elements removed. The observers are notified of each removal.
+func (l *observableList) RemoveAll(other collection.Collection) int {
+    return collection.RemoveAll(l, other)
+}
+
+// RetainAll removes every element from the ObservableList that does not exist in the provided collection, and returns
+// the number of elements removed. The observers are notified of each removal.
+func (l *observableList) RetainAll(other collection.Collection) int {
+    return collection.RetainAll(l, other)
+}
+
+// RemoveFirst removes the element at the front (index == 0) of the ObservableList and returns it. If the ObservableList
+// is not empty, the observers are notified that the element was removed.
+func (l *observableList) RemoveFirst() interface{} {
+    if l.delegate.IsEmpty() {
+        return nil
+    }
+
+    element := l.delegate.RemoveFirst()
+    l.notify(Removed, element)
+
+    return element
+}
+
+// RemoveLast removes the element at the end (index == ObservableList.Size() - 1) of the ObservableList and returns it.
+// If the ObservableList is not empty, the observers are notified that the element was removed.
+func (l *observableList) RemoveLast() interface{} {
+    if l.delegate.IsEmpty() {
+        return nil
+    }
+
+    element := l.delegate.RemoveLast()
+    l.notify(Removed, element)
+
+    return element
+}
+
+// RemoveWithIndex removes the element at the provided index from the ObservableList and returns it, and notifies the
+// observers that it was removed.
+func (l *observableList) RemoveWithIndex(index int) (interface{}, error) {
+    element, err := l.delegate.RemoveWithIndex(index)
+    if err != nil {
+        return nil, err
+    }
+    l.notify(Removed, element)
+
+    return element, nil
+}
+
+// RemoveFirstMatch removes the first element of the ObservableList that matches the given predicate and returns it, and
+// notifies the observers that it was removed.
+func (l *observableList) RemoveFirstMatch(predicate func(element interface{}) bool) (interface{}, bool) {
+    element, found := l.delegate.RemoveFirstMatch(predicate)
+    if found {
+        l.notify(Removed, element)
+    }
+
+    return element, found
+}
+
+// RemoveRange removes the elements of the ObservableList at positions from (inclusive) to to (exclusive), and notifies
+// the observers that each of them was removed. The returned error will be non-nil if the provided range is outside the
+// current bounds of the ObservableList (from < 0 || from > to || to > ObservableList.Size()).
+func (l *observableList) RemoveRange(from int, to int) error {
+    if len(l.observers) == 0 {
+        return l.delegate.RemoveRange(from, to)
+    }
+
+    elements := l.valuesInRange(from, to)
+    if err := l.delegate.RemoveRange(from, to); err != nil {
+        return err
+    }
+    l.notifyAll(Removed, elements)
+
+    return nil
+}
+
+// Splice removes the elements of the ObservableList at positions from (inclusive) to to (exclusive), notifies the
+// observers that each of them was removed, and returns them as a new List of the same kind as the delegate, which is
+// not observable. The returned error will be non-nil if the provided range is outside the current bounds of the
+// ObservableList (from < 0 || from > to || to > ObservableList.Size()).
+func (l *observableList) Splice(from int, to int) (List, error) {
+    spliced, err := l.delegate.Splice(from, to)
+    if err != nil {
+        return nil, err
+    }
+
+    if len(l.observers) > 0 {
+        l.notifyAll(Removed, spliced.Values())
+    }
+
+    return spliced, nil
+}
+
+// Compact removes every nil element from the ObservableList, and returns the number of elements removed. The observers
+// are notified of each removal.
+func (l *observableList) Compact() int {
+    removed := l.delegate.Compact()
+    for i := 0; i < removed; i++ {
+        l.notify(Removed, nil)
+    }
+
+    return removed
+}
+
+// InsertSorted inserts the provided element into the ObservableList after any elements that are not greater than it
+// according to the provided comparator, and returns the position where the element was placed. If the element was
+// inserted, the observers are notified that it was added.
+func (l *observableList) InsertSorted(element interface{}, less func(a, b interface{}) bool) int {
+    index := l.delegate.InsertSorted(element, less)
+    if index != collection.ElementNotFound {
+        l.notify(Added, element)
+    }
+
+    return index
+}
+
+// Fill replaces every element of the ObservableList with the provided value, and notifies the observers of each
+// replacement.
+func (l *observableList) Fill(value interface{}) {
+    l.delegate.Fill(value)
+    for i := 0; i < l.delegate.Size(); i++ {
+        l.notify(Replaced, value)
+    }
+}
+
+// FillRange replaces the elements of the ObservableList at positions from (inclusive) to to (exclusive) with the
+// provided value, and notifies the observers of each replacement. The returned error will be non-nil if the provided
+// range is outside the current bounds of the ObservableList (from < 0 || from > to || to > ObservableList.Size()).
+func (l *observableList) FillRange(from int, to int, value interface{}) error {
+    if err := l.delegate.FillRange(from, to, value); err != nil {
+        return err
+    }
+
+    for i := from; i < to; i++ {
+        l.notify(Replaced, value)
+    }
+
+    return nil
+}
+
+// Apply replaces each element of the ObservableList with the result of applying the provided function to it, and
+// notifies the observers of each replacement with the resulting element.
+func (l *observableList) Apply(mapper func(element interface{}) interface{}) {
+    l.delegate.Apply(mapper)
+
+    if len(l.observers) > 0 {
+        l.notifyAll(Replaced, l.delegate.Values())
+    }
+}
+
+// Filter returns a new List consisting of the elements of this ObservableList that match the given predicate. The
+// returned List is of the same kind as the delegate, and is not observable.
+func (l *observableList) Filter(predicate func(element interface{}) bool) List {
+    return l.delegate.Filter(predicate)
+}
+
+// FilterIndexed returns a new List consisting of the elements of this ObservableList that match the given predicate,
+// which is provided the position of each element in this ObservableList along with the element. The returned List is
+// of the same kind as the delegate, and is not observable.
+func (l *observableList) FilterIndexed(predicate func(index int, element interface{}) bool) List {
+    return l.delegate.FilterIndexed(predicate)
+}
+
+// Find returns the first element of the ObservableList that matches the given predicate, and true if such an element
+// exists.
+func (l *observableList) Find(predicate func(element interface{}) bool) (interface{}, bool) {
+    return l.delegate.Find(predicate)
+}
+
+// Any returns true if at least one element of the ObservableList matches the given predicate, otherwise false is
+// returned.
+func (l *observableList) Any(predicate func(element interface{}) bool) bool {
+    return l.delegate.Any(predicate)
+}
+
+// All returns true if every element of the ObservableList matches the given predicate, otherwise false is returned.
+func (l *observableList) All(predicate func(element interface{}) bool) bool {
+    return l.delegate.All(predicate)
+}
+
+// Map returns a new List containing the resulting elements of applying the given function to the elements of this
+// ObservableList. The returned List is of the same kind as the delegate, and is not observable.
+func (l *observableList) Map(mapper func(element interface{}) interface{}) List {
+    return l.delegate.Map(mapper)
+}
+
+// MapError returns a new List containing the resulting elements of applying the given function to the elements of this
+// ObservableList (see List.MapError). The returned List is of the same kind as the delegate, and is not observable.
+func (l *observableList) MapError(mapper func(element interface{}) (interface{}, error)) (List, error) {
+    return l.delegate.MapError(mapper)
+}
+
+// ForEach performs the provided consumer function for each element of the ObservableList.
+func (l *observableList) ForEach(consumer func(element interface{})) {
+    l.delegate.ForEach(consumer)
+}
+
+// Stream returns a channel over which the elements of the ObservableList are sent in iteration order, which is closed
+// once every element has been sent.
+func (l *observableList) Stream() <-chan interface{} {
+    return collection.Stream(l, nil)
+}
+
+// ToSlice copies the elements of the ObservableList into the slice pointed to by the provided destination (e.g.
+// *[]string), replacing its contents.
+func (l *observableList) ToSlice(dst interface{}) error {
+    return l.delegate.ToSlice(dst)
+}
+
+// CopyTo copies as many elements of the ObservableList as fit into the provided slice, and returns the number copied.
+func (l *observableList) CopyTo(dst []interface{}) int {
+    return l.delegate.CopyTo(dst)
+}
+
+// Size returns the number of elements in the ObservableList.
+func (l *observableList) Size() int {
+    return l.delegate.Size()
+}
+
+// IsEmpty returns true if the ObservableList contains no elements, otherwise false is returned.
+func (l *observableList) IsEmpty() bool {
+    return l.delegate.IsEmpty()
+}
+
+// Capacity returns the capacity of the delegate List, or collection.Unbounded if it is unbounded.
+func (l *observableList) Capacity() int {
+    return collection.Capacity(l.delegate)
+}
+
+// IsFull returns true if the delegate List is bounded and has reached capacity, otherwise false is returned.
+func (l *observableList) IsFull() bool {
+    return collection.IsFull(l.delegate)
+}
+
+// Clear removes all elements from the ObservableList, and notifies the observers with a single Cleared event.
+func (l *observableList) Clear() {
+    l.delegate.Clear()
+    l.notify(Cleared, nil)
+}
+
+// Contains returns true if an element equivalent to the provided element exists in the ObservableList, otherwise false
+// is returned.
+func (l *observableList) Contains(element interface{}) bool {
+    return l.delegate.Contains(element)
+}
+
+// ContainsAll returns true if every element of the provided collection exists in the ObservableList, otherwise false
+// is returned. A nil or empty collection is contained by every ObservableList.
+func (l *observableList) ContainsAll(other collection.Collection) bool {
+    return l.delegate.ContainsAll(other)
+}
+
+// ContainsAllOf returns a map from each of the provided elements to true if it exists in the ObservableList, otherwise
+// false. Elements that are not hashable cannot be keys of the map, and are omitted.
+func (l *observableList) ContainsAllOf(elements []interface{}) map[interface{}]bool {
+    return l.delegate.ContainsAllOf(elements)
+}
+
+// EqualsIgnoreOrder returns true if the provided List contains the same elements as the ObservableList with the same
+// number of occurrences, regardless of their positions. A nil List is not equal to any List.
+func (l *observableList) EqualsIgnoreOrder(other List) bool {
+    return other != nil && collection.Equals(l, other)
+}
+
+// Values returns a slice containing the elements in the ObservableList in the iteration order.
+func (l *observableList) Values() []interface{} {
+    return l.delegate.Values()
+}
+
+// Clone returns a clone of the delegate List. As with Filter and Map, the returned List is not observable, so that its
+// modifications are not reported to the observers of the ObservableList.
+func (l *observableList) Clone() collection.Collection {
+    return l.delegate.Clone()
+}
+
+// Iterator returns an Iterator positioned before the first element of the ObservableList.
+func (l *observableList) Iterator() collection.Iterator {
+    return l.delegate.Iterator()
+}
+
+// ReverseIterator returns an Iterator positioned after the last element of the ObservableList, which traverses the
+// elements from the last to the first.
+func (l *observableList) ReverseIterator() collection.Iterator {
+    return l.delegate.ReverseIterator()
+}
+
+// String returns a string representation of the ObservableList in it's current state.
+func (l *observableList) String() string {
+    return fmt.Sprintf("%v", l.delegate)
+}
+
+// StringQuoted returns a string representation of the ObservableList in which string elements are quoted and nil
+// elements are rendered as nil.
+func (l *observableList) StringQuoted() string {
+    return l.delegate.StringQuoted()
+}
+
+// observe registers the provided observer of the ObservableList.
+func (l *observableList) observe(observer func(event MutationEvent)) {
+    l.observers = append(l.observers, observer)
+}
+
+// notify notifies the observers of the ObservableList of the provided operation on the provided element.
+func (l *observableList) notify(operation MutationOperation, element interface{}) {
+    for _, observer := range l.observers {
+        observer(MutationEvent{ Operation: operation, Element: element })
+    }
+}
+
+// notifyAll notifies the observers of the ObservableList of the provided operation on each of the provided elements.
+func (l *observableList) notifyAll(operation MutationOperation, elements []interface{}) {
+    if len(l.observers) == 0 {
+        return
+    }
+
+    for _, element := range elements {
+        l.notify(operation, element)
+    }
+}
+
+// valuesInRange returns the elements of the delegate at positions from (inclusive) to to (exclusive), or nil if the
+// provided range is outside the current bounds of the delegate.
+func (l *observableList) valuesInRange(from int, to int) []interface{} {
+    if from < 0 || from > to || to > l.delegate.Size() {
+        return nil
+    }
+
+    return l.delegate.Values()[from:to]
+}
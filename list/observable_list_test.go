@@ -0,0 +1,85 @@
+package list
+
+import (
+    "fmt"
+    "testing"
+
+    "github.com/2speed/go-collection"
+)
+
+func TestObservableList_Events(t *testing.T) {
+    list, observe := NewObservableList(NewArrayList())
+
+    var events []MutationEvent
+    observe(func(event MutationEvent) { events = append(events, event) })
+
+    for _, c := range []struct {
+        name     string
+        mutate   func()
+        expected string
+    }{
+        {
+            name:     "Add",
+            mutate:   func() { _ = list.Add("mario") },
+            expected: "[{Added mario}]",
+        },
+        {
+            name:     "AddAll",
+            mutate:   func() { _ = list.AddAll(NewArrayListOf([]interface{}{ "luigi", "peach" })) },
+            expected: "[{Added luigi} {Added peach}]",
+        },
+        {
+            name:     "Remove",
+            mutate:   func() { list.Remove("luigi") },
+            expected: "[{Removed luigi}]",
+        },
+        {
+            name:     "RemoveMissing",
+            mutate:   func() { list.Remove("bowser") },
+            expected: "[]",
+        },
+        {
+            name:     "RemoveAll",
+            mutate:   func() { list.RemoveAll(NewArrayListOf([]interface{}{ "mario", "peach" })) },
+            expected: "[{Removed mario} {Removed peach}]",
+        },
+        {
+            name:     "Clear",
+            mutate:   func() { _ = list.AddValues("toad", "yoshi"); events = nil; list.Clear() },
+            expected: "[{Cleared <nil>}]",
+        },
+    } {
+        t.Run(c.name, func(t *testing.T) {
+            events = nil
+            c.mutate()
+
+            if actual := fmt.Sprintf("%v", events); actual != c.expected {
+                t.Errorf("expected events '%s', but found '%s'", c.expected, actual)
+            }
+        })
+    }
+
+    assertSize(t, list, 0)
+}
+
+func TestObservableList_FailedMutation(t *testing.T) {
+    list, observe := NewObservableList(NewCircularList(1))
+
+    var events []MutationEvent
+    observe(func(event MutationEvent) { events = append(events, event) })
+
+    assertError(t, list.Add("kirby"), nil)
+    assertError(t, list.Add("dedede"), collection.ErrorCapacityReached)
+
+    if len(events) != 1 || events[0] != (MutationEvent{ Operation: Added, Element: "kirby" }) {
+        t.Errorf("expected a single Added event, but found '%v'", events)
+    }
+}
+
+func TestObservableList_Unobserved(t *testing.T) {
+    list, _ := NewObservableList(NewLinkedList())
+
+    assertError(t, list.AddValues(1, 2, 3, 4), nil)
+    assertError(t, list.RemoveRange(1, 3), nil)
+    assertContentEquals(t, list, "[1, 4]")
+}
@@ -0,0 +1,316 @@
+package list
+
+import (
+    "sort"
+
+    "github.com/2speed/go-collection"
+    "github.com/pkg/errors"
+)
+
+// SortedList defines the behavior for an Ordered Collection whose elements are maintained in ascending order by a
+// comparator, and are accessible via their position. Two elements are considered equivalent if neither is less than
+// the other according to the comparator.
+type SortedList interface {
+    collection.Ordered
+
+    // ValueWithIndex returns the element at the position specified by the provided index. The returned error will be
+    // non-nil if the provided index is outside the current bounds of the SortedList
+    // (index < 0 || index > SortedList.Size() - 1).
+    ValueWithIndex(index int) (interface{}, error)
+
+    // IndexOf returns the position of the first occurrence (if any) of an element equivalent to the provided element.
+    // The returned error will be non-nil if provided element is not found in the SortedList, and the returned index
+    // will be -1.
+    IndexOf(element interface{}) (int, error)
+
+    // RemoveWithIndex removes the element at the provided index from the SortedList and returns it. The returned error
+    // will be non-nil if the provided index is outside the bounds of the SortedList
+    // (index < 0 || index > SortedList.Size() - 1).
+    RemoveWithIndex(index int) (interface{}, error)
+
+    // Filter returns a new SortedList ordered by the same comparator consisting of the elements of this SortedList that
+    // match the given predicate.
+    Filter(predicate func(element interface{}) bool) SortedList
+
+    // Map returns a new SortedList ordered by the same comparator containing the resulting elements of applying the
+    // given function to the elements of this SortedList. The resulting elements are sorted by the comparator, so they
+    // may be in a different order than the elements they were mapped from.
+    Map(mapper func(element interface{}) interface{}) SortedList
+
+    // ForEach performs the provided consumer function for each element of the SortedList in ascending order.
+    ForEach(consumer func(element interface{}))
+}
+
+// sortedList maintains its elements in a slice that is kept sorted by placing each inserted element with a binary
+// search. Insertion is stable, so equivalent elements are iterated in the order they were added.
+type sortedList struct {
+    elements []interface{}
+    less     func(a, b interface{}) bool
+}
+
+// NewSortedList creates a new SortedList ordered by the provided comparator, which returns true if a is less than b.
+// Contains and IndexOf are O(log n), while Add and Remove are O(n) due to shifting the elements that follow.
+func NewSortedList(less func(a, b interface{}) bool) SortedList {
+    return &sortedList{
+        elements: make([]interface{}, 0),
+        less:     less,
+    }
+}
+
+// Add inserts the provided element into the SortedList after any equivalent elements.
+func (l *sortedList) Add(element interface{}) error {
+    index := l.upperBound(element)
+
+    l.elements = append(l.elements, nil)
+    copy(l.elements[index + 1:], l.elements[index:])
+    l.elements[index] = element
+
+    return nil
+}
+
+// AddAll inserts all elements from the provided Collection into the SortedList.
+func (l *sortedList) AddAll(collection collection.Collection) error {
+    if collection != nil {
+        for _, v := range collection.Values() {
+            _ = l.Add(v)
+        }
+    }
+
+    return nil
+}
+
+// ValueWithIndex returns the element at the position specified by the provided index. The returned error will be
+// non-nil if the provided index is outside the current bounds of the SortedList
+// (index < 0 || index > SortedList.Size() - 1).
+func (l *sortedList) ValueWithIndex(index int) (interface{}, error) {
+    if err := l.checkBounds(index); err != nil {
+        return nil, err
+    }
+
+    return l.elements[index], nil
+}
+
+// IndexOf returns the position of the first occurrence (if any) of an element equivalent to the provided element. The
+// returned error will be non-nil if provided element is not found in the SortedList, and the returned index will be
+// equal to collection.ElementNotFound.
+func (l *sortedList) IndexOf(element interface{}) (int, error) {
+    index := l.lowerBound(element)
+    if index < l.Size() && !l.less(element, l.elements[index]) {
+        return index, nil
+    }
+
+    return collection.ElementNotFound, collection.ErrorElementNotFound
+}
+
+// Remove removes the first occurrence (if any) of an element equivalent to the provided element. If an element was
+// removed, the return value will be true, otherwise false will be returned.
+func (l *sortedList) Remove(element interface{}) bool {
+    if index, err := l.IndexOf(element); err == nil {
+        _, _ = l.RemoveWithIndex(index)
+
+        return true
+    }
+
+    return false
+}
+
+// RemoveAll removes every occurrence of each element of the provided collection from the SortedList, and returns the
+// number of elements removed. A nil collection removes no elements.
+func (l *sortedList) RemoveAll(other collection.Collection) int {
+    return collection.RemoveAll(l, other)
+}
+
+// RetainAll removes every element from the SortedList that does not exist in the provided collection, and returns the
+// number of elements removed. A nil collection is treated as an empty collection.
+func (l *sortedList) RetainAll(other collection.Collection) int {
+    return collection.RetainAll(l, other)
+}
+
+// RemoveWithIndex removes the element at the provided index from the SortedList and returns it. The returned error
+// will be non-nil if the provided index is outside the bounds of the SortedList
+// (index < 0 || index > SortedList.Size() - 1).
+func (l *sortedList) RemoveWithIndex(index int) (interface{}, error) {
+    element, err := l.ValueWithIndex(index)
+    if err != nil {
+        return nil, err
+    }
+
+    last := l.Size() - 1
+
+    copy(l.elements[index:last], l.elements[index + 1:])
+    l.elements[last] = nil
+    l.elements       = l.elements[:last]
+
+    return element, nil
+}
+
+// Min returns the lowest element in the SortedList, or nil if the SortedList is empty.
+func (l *sortedList) Min() interface{} {
+    if l.IsEmpty() {
+        return nil
+    }
+
+    return l.elements[0]
+}
+
+// Max returns the highest element in the SortedList, or nil if the SortedList is empty.
+func (l *sortedList) Max() interface{} {
+    if l.IsEmpty() {
+        return nil
+    }
+
+    return l.elements[l.Size() - 1]
+}
+
+// Predecessor returns the element (if any) from the SortedList that is less than the provided element. More
+// specifically, the element before the first occurrence of an element equivalent to the provided element is returned.
+func (l *sortedList) Predecessor(element interface{}) interface{} {
+    if index := l.lowerBound(element) - 1; index >= 0 {
+        return l.elements[index]
+    }
+
+    return nil
+}
+
+// Successor returns the element (if any) from the SortedList that is greater than the provided element. More
+// specifically, the element after the last occurrence of an element equivalent to the provided element is returned.
+func (l *sortedList) Successor(element interface{}) interface{} {
+    if index := l.upperBound(element); index < l.Size() {
+        return l.elements[index]
+    }
+
+    return nil
+}
+
+// Floor returns the element (if any) from the SortedList that is less than or equal to the provided element. More
+// specifically, the last occurrence of an equivalent element, or otherwise the predecessor, is returned.
+func (l *sortedList) Floor(element interface{}) interface{} {
+    if index := l.upperBound(element) - 1; index >= 0 {
+        return l.elements[index]
+    }
+
+    return nil
+}
+
+// Ceiling returns the element (if any) from the SortedList that is greater than or equal to the provided element. More
+// specifically, the first occurrence of an equivalent element, or otherwise the successor, is returned.
+func (l *sortedList) Ceiling(element interface{}) interface{} {
+    if index := l.lowerBound(element); index < l.Size() {
+        return l.elements[index]
+    }
+
+    return nil
+}
+
+// Filter returns a new SortedList ordered by the same comparator consisting of the elements of this SortedList that
+// match the given predicate. The elements are already in order, so they are appended without searching.
+func (l *sortedList) Filter(predicate func(element interface{}) bool) SortedList {
+    list := &sortedList{
+        elements: make([]interface{}, 0, l.Size()),
+        less:     l.less,
+    }
+
+    for _, v := range l.elements {
+        if predicate(v) {
+            list.elements = append(list.elements, v)
+        }
+    }
+
+    return list
+}
+
+// Map returns a new SortedList ordered by the same comparator containing the resulting elements of applying the given
+// function to the elements of this SortedList.
+func (l *sortedList) Map(mapper func(element interface{}) interface{}) SortedList {
+    list := &sortedList{
+        elements: make([]interface{}, 0, l.Size()),
+        less:     l.less,
+    }
+
+    for _, v := range l.elements {
+        _ = list.Add(mapper(v))
+    }
+
+    return list
+}
+
+// ForEach performs the provided consumer function for each element of the SortedList in ascending order.
+func (l *sortedList) ForEach(consumer func(element interface{})) {
+    for _, v := range l.elements {
+        consumer(v)
+    }
+}
+
+// Size returns the number of elements in the SortedList.
+func (l *sortedList) Size() int {
+    return len(l.elements)
+}
+
+// IsEmpty returns true if the SortedList contains no elements, otherwise false is returned.
+func (l *sortedList) IsEmpty() bool {
+    return l.Size() == 0
+}
+
+// Clear removes all elements from the SortedList.
+func (l *sortedList) Clear() {
+    for i := range l.elements {
+        l.elements[i] = nil
+    }
+    l.elements = l.elements[:0]
+}
+
+// Contains returns true if an element equivalent to the provided element exists in the SortedList, otherwise false is
+// returned.
+func (l *sortedList) Contains(element interface{}) bool {
+    _, err := l.IndexOf(element)
+
+    return err == nil
+}
+
+// ContainsAll returns true if every element of the provided collection exists in the SortedList, otherwise false is
+// returned. A nil or empty collection is contained by every SortedList.
+func (l *sortedList) ContainsAll(other collection.Collection) bool {
+    return collection.ContainsAll(l, other)
+}
+
+// Values returns a slice containing the elements in the SortedList in ascending order.
+func (l *sortedList) Values() []interface{} {
+    elements := make([]interface{}, l.Size())
+    copy(elements, l.elements)
+
+    return elements
+}
+
+// Clone returns a new SortedList with the same comparator containing the elements of the SortedList. The elements
+// themselves are not copied.
+func (l *sortedList) Clone() collection.Collection {
+    return &sortedList{ elements: l.Values(), less: l.less }
+}
+
+// Iterator returns an Iterator positioned before the first element of the SortedList.
+func (l *sortedList) Iterator() collection.Iterator {
+    return newIndexIterator(l)
+}
+
+// String returns a string representation of the SortedList in it's current state.
+func (l *sortedList) String() string {
+    return collection.Format(l)
+}
+
+func (l *sortedList) checkBounds(index int) error {
+    if index < 0 || index >= l.Size() {
+        return errors.Errorf("index out of bounds [*SortedList.Size() = %v, requested index = %v]", l.Size(), index)
+    }
+
+    return nil
+}
+
+// lowerBound returns the index of the first element that is not less than the provided element.
+func (l *sortedList) lowerBound(element interface{}) int {
+    return sort.Search(l.Size(), func(i int) bool { return !l.less(l.elements[i], element) })
+}
+
+// upperBound returns the index of the first element that is greater than the provided element.
+func (l *sortedList) upperBound(element interface{}) int {
+    return sort.Search(l.Size(), func(i int) bool { return l.less(element, l.elements[i]) })
+}
@@ -0,0 +1,118 @@
+package list
+
+import (
+    "math/rand"
+    "testing"
+
+    "github.com/2speed/go-collection"
+)
+
+func byInt(a, b interface{}) bool {
+    return a.(int) < b.(int)
+}
+
+func TestSortedList_Add(t *testing.T) {
+    list   := NewSortedList(byInt)
+    random := rand.New(rand.NewSource(42))
+
+    for i := 0; i < 200; i++ {
+        assertError(t, list.Add(random.Intn(100)), nil)
+    }
+
+    if list.Size() != 200 {
+        t.Errorf("expected size of '200', but found '%d'", list.Size())
+    }
+
+    previous := -1
+    list.ForEach(func(element interface{}) {
+        if element.(int) < previous {
+            t.Errorf("expected elements in ascending order, but found '%d' after '%d'", element, previous)
+        }
+        previous = element.(int)
+    })
+}
+
+func TestSortedList_Search(t *testing.T) {
+    list := NewSortedList(byInt)
+    _ = list.AddAll(NewArrayListOf([]interface{}{ 50, 10, 30, 30, 40, 20 }))
+
+    assertContentEquals(t, list, "[10, 20, 30, 30, 40, 50]")
+
+    index, err := list.IndexOf(30)
+    assertError(t, err, nil)
+    if index != 2 {
+        t.Errorf("expected index of '2', but found '%d'", index)
+    }
+
+    index, err = list.IndexOf(35)
+    assertError(t, err, collection.ErrorElementNotFound)
+    if index != collection.ElementNotFound {
+        t.Errorf("expected index of '%d', but found '%d'", collection.ElementNotFound, index)
+    }
+
+    assertContains(t, list, 40, true)
+    assertContains(t, list, 45, false)
+}
+
+func TestSortedList_Functional(t *testing.T) {
+    list := NewSortedList(byInt)
+    _ = list.AddAll(NewArrayListOf([]interface{}{ 50, 10, 30, 30, 40, 20 }))
+
+    evens := list.Filter(func(element interface{}) bool { return element.(int) % 20 == 0 })
+    assertContentEquals(t, evens, "[20, 40]")
+
+    negated := list.Map(func(element interface{}) interface{} { return -element.(int) })
+    assertContentEquals(t, negated, "[-50, -40, -30, -30, -20, -10]")
+
+    assertError(t, negated.Add(0), nil)
+    assertError(t, negated.Add(-35), nil)
+    assertContentEquals(t, negated, "[-50, -40, -35, -30, -30, -20, -10, 0]")
+
+    for _, result := range []SortedList{ evens, negated } {
+        if _, ok := result.(*sortedList); !ok {
+            t.Errorf("expected result of type '*list.sortedList', but found '%T'", result)
+        }
+    }
+
+    assertContentEquals(t, list, "[10, 20, 30, 30, 40, 50]")
+}
+
+func TestSortedList_Ordered(t *testing.T) {
+    list := NewSortedList(byInt)
+    _ = list.AddAll(NewArrayListOf([]interface{}{ 40, 10, 30, 30, 50, 20 }))
+
+    for _, c := range []struct {
+        name     string
+        actual   interface{}
+        expected interface{}
+    }{
+        { name: "Min",                actual: list.Min(),             expected: 10 },
+        { name: "Max",                actual: list.Max(),             expected: 50 },
+        { name: "Predecessor",        actual: list.Predecessor(30),   expected: 20 },
+        { name: "Predecessor,Absent", actual: list.Predecessor(35),   expected: 30 },
+        { name: "Predecessor,Min",    actual: list.Predecessor(10),   expected: nil },
+        { name: "Successor",          actual: list.Successor(30),     expected: 40 },
+        { name: "Successor,Absent",   actual: list.Successor(5),      expected: 10 },
+        { name: "Successor,Max",      actual: list.Successor(50),     expected: nil },
+        { name: "Floor",              actual: list.Floor(30),         expected: 30 },
+        { name: "Floor,Absent",       actual: list.Floor(35),         expected: 30 },
+        { name: "Floor,Min",          actual: list.Floor(5),          expected: nil },
+        { name: "Ceiling",            actual: list.Ceiling(30),       expected: 30 },
+        { name: "Ceiling,Absent",     actual: list.Ceiling(35),       expected: 40 },
+        { name: "Ceiling,Max",        actual: list.Ceiling(55),       expected: nil },
+    } {
+        if c.actual != c.expected {
+            t.Errorf("%s: expected '%v', but found '%v'", c.name, c.expected, c.actual)
+        }
+    }
+
+    if !list.Remove(30) || !list.Remove(30) || list.Remove(30) {
+        t.Error("expected exactly two removals of '30'")
+    }
+    assertContentEquals(t, list, "[10, 20, 40, 50]")
+
+    list.Clear()
+    if list.Min() != nil || list.Max() != nil {
+        t.Error("expected nil Min and Max after Clear")
+    }
+}
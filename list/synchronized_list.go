@@ -0,0 +1,460 @@
+package list
+
+import (
+    "fmt"
+    "sync"
+
+    "github.com/2speed/go-collection"
+)
+
+// SynchronizedList is a List decorator that guards access to a delegate List with a sync.RWMutex. Accessors hold the
+// read lock and mutators hold the write lock. ForEach, Filter, and Map hold the read lock for the duration of the
+// iteration, so the provided functions must not modify the SynchronizedList. The Lists returned by Filter and Map are
+// also synchronized.
+type synchronizedList struct {
+    mutex    sync.RWMutex
+    delegate List
+}
+
+// NewSynchronizedList creates a new SynchronizedList that guards access to the provided List. The provided List should
+// not be accessed directly after it has been wrapped.
+func NewSynchronizedList(delegate List) List {
+    return &synchronizedList{ delegate: delegate }
+}
+
+// Add inserts the provided element into the SynchronizedList.
+func (l *synchronizedList) Add(element interface{}) error {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.Add(element)
+}
+
+// AddAll inserts all elements from the provided Collection into the SynchronizedList. The elements of the provided
+// Collection are read before the write lock is acquired, so a SynchronizedList may be added to itself.
+func (l *synchronizedList) AddAll(c collection.Collection) error {
+    if c == nil {
+        return nil
+    }
+
+    elements := NewArrayListOf(c.Values())
+
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.AddAll(elements)
+}
+
+// AddFirst inserts the provided element at the front (index == 0) of the SynchronizedList.
+func (l *synchronizedList) AddFirst(element interface{}) error {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.AddFirst(element)
+}
+
+// AddLast inserts the provided element at the end of the SynchronizedList (index == SynchronizedList.Size()).
+func (l *synchronizedList) AddLast(element interface{}) error {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.AddLast(element)
+}
+
+// AddValues inserts the provided elements at the end of the SynchronizedList in the order they are provided.
+func (l *synchronizedList) AddValues(elements ...interface{}) error {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.AddValues(elements...)
+}
+
+// AddWithIndex inserts the provided element into the SynchronizedList specified by index.
+func (l *synchronizedList) AddWithIndex(index int, element interface{}) error {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.AddWithIndex(index, element)
+}
+
+// ValueWithIndex returns the element at the position specified by the provided index.
+func (l *synchronizedList) ValueWithIndex(index int) (interface{}, error) {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return l.delegate.ValueWithIndex(index)
+}
+
+// IndexOf returns the position of the first occurrence (if any) of an element equivalent to the provided element.
+func (l *synchronizedList) IndexOf(element interface{}) (int, error) {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return l.delegate.IndexOf(element)
+}
+
+// Remove removes the first occurrence (if any) of an element equivalent to the provided element.
+func (l *synchronizedList) Remove(element interface{}) bool {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.Remove(element)
+}
+
+// RemoveAll removes every occurrence of each element of the provided collection from the SynchronizedList, and returns
+// the number of elements removed. A nil collection removes no elements. The elements of the provided collection are
+// read before the SynchronizedList is locked.
+func (l *synchronizedList) RemoveAll(other collection.Collection) int {
+    if other == nil {
+        return 0
+    }
+
+    elements := NewArrayListOf(other.Values())
+
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.RemoveAll(elements)
+}
+
+// RetainAll removes every element from the SynchronizedList that does not exist in the provided collection, and returns
+// the number of elements removed. A nil collection is treated as an empty collection. The elements of the provided
+// collection are read before the SynchronizedList is locked.
+func (l *synchronizedList) RetainAll(other collection.Collection) int {
+    elements := NewArrayList()
+    if other != nil {
+        elements = NewArrayListOf(other.Values())
+    }
+
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.RetainAll(elements)
+}
+
+// RemoveFirst removes the element at the front (index == 0) of the SynchronizedList and returns it.
+func (l *synchronizedList) RemoveFirst() interface{} {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.RemoveFirst()
+}
+
+// RemoveLast removes the element at the end (index == SynchronizedList.Size() - 1) of the SynchronizedList and returns
+// it.
+func (l *synchronizedList) RemoveLast() interface{} {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.RemoveLast()
+}
+
+// RemoveWithIndex removes the element at the provided index from the SynchronizedList and returns it.
+func (l *synchronizedList) RemoveWithIndex(index int) (interface{}, error) {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.RemoveWithIndex(index)
+}
+
+// RemoveFirstMatch removes the first element of the SynchronizedList that matches the given predicate and returns it.
+// The write lock is held while the predicate is evaluated, so the predicate must not access the SynchronizedList.
+func (l *synchronizedList) RemoveFirstMatch(predicate func(element interface{}) bool) (interface{}, bool) {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.RemoveFirstMatch(predicate)
+}
+
+// RemoveRange removes the elements of the SynchronizedList at positions from (inclusive) to to (exclusive). The
+// returned error will be non-nil if the provided range is outside the current bounds of the SynchronizedList
+// (from < 0 || from > to || to > SynchronizedList.Size()).
+func (l *synchronizedList) RemoveRange(from int, to int) error {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.RemoveRange(from, to)
+}
+
+// Splice removes the elements of the SynchronizedList at positions from (inclusive) to to (exclusive), and returns them
+// as a new SynchronizedList. The returned error will be non-nil if the provided range is outside the current bounds of
+// the SynchronizedList (from < 0 || from > to || to > SynchronizedList.Size()).
+func (l *synchronizedList) Splice(from int, to int) (List, error) {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    spliced, err := l.delegate.Splice(from, to)
+    if err != nil {
+        return nil, err
+    }
+
+    return NewSynchronizedList(spliced), nil
+}
+
+// Compact removes every nil element from the SynchronizedList, and returns the number of elements removed.
+func (l *synchronizedList) Compact() int {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.Compact()
+}
+
+// InsertSorted inserts the provided element into the SynchronizedList after any elements that are not greater than it
+// according to the provided comparator, and returns the position where the element was placed. The SynchronizedList is
+// assumed to already be sorted by the comparator.
+func (l *synchronizedList) InsertSorted(element interface{}, less func(a, b interface{}) bool) int {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.InsertSorted(element, less)
+}
+
+// Fill replaces every element of the SynchronizedList with the provided value. The size of the SynchronizedList is
+// unchanged.
+func (l *synchronizedList) Fill(value interface{}) {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    l.delegate.Fill(value)
+}
+
+// FillRange replaces the elements of the SynchronizedList at positions from (inclusive) to to (exclusive) with the
+// provided value. The returned error will be non-nil if the provided range is outside the current bounds of the
+// SynchronizedList (from < 0 || from > to || to > SynchronizedList.Size()).
+func (l *synchronizedList) FillRange(from int, to int, value interface{}) error {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    return l.delegate.FillRange(from, to, value)
+}
+
+// Apply replaces each element of the SynchronizedList with the result of applying the provided function to it. The
+// write lock is held while the function is applied, so the function must not access the SynchronizedList.
+func (l *synchronizedList) Apply(mapper func(element interface{}) interface{}) {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    l.delegate.Apply(mapper)
+}
+
+// Filter returns a new SynchronizedList consisting of the elements of this SynchronizedList that match the given
+// predicate.
+func (l *synchronizedList) Filter(predicate func(element interface{}) bool) List {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return NewSynchronizedList(l.delegate.Filter(predicate))
+}
+
+// FilterIndexed returns a new SynchronizedList consisting of the elements of this SynchronizedList that match the
+// given predicate, which is provided the position of each element in this SynchronizedList along with the element.
+func (l *synchronizedList) FilterIndexed(predicate func(index int, element interface{}) bool) List {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return NewSynchronizedList(l.delegate.FilterIndexed(predicate))
+}
+
+// Find returns the first element of the SynchronizedList that matches the given predicate, and true if such an element
+// exists. The read lock is held while the predicate is evaluated, so the predicate must not modify the SynchronizedList.
+func (l *synchronizedList) Find(predicate func(element interface{}) bool) (interface{}, bool) {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return l.delegate.Find(predicate)
+}
+
+// Any returns true if at least one element of the SynchronizedList matches the given predicate, otherwise false is
+// returned.
+func (l *synchronizedList) Any(predicate func(element interface{}) bool) bool {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return l.delegate.Any(predicate)
+}
+
+// All returns true if every element of the SynchronizedList matches the given predicate, otherwise false is returned.
+func (l *synchronizedList) All(predicate func(element interface{}) bool) bool {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return l.delegate.All(predicate)
+}
+
+// Map returns a new SynchronizedList containing the resulting elements of applying the given function to the elements
+// of this SynchronizedList.
+func (l *synchronizedList) Map(mapper func(element interface{}) interface{}) List {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return NewSynchronizedList(l.delegate.Map(mapper))
+}
+
+// MapError returns a new SynchronizedList containing the resulting elements of applying the given function to the
+// elements of this SynchronizedList. If the function returns an error, mapping stops and the error is returned along
+// with the SynchronizedList of the elements mapped before the failure.
+func (l *synchronizedList) MapError(mapper func(element interface{}) (interface{}, error)) (List, error) {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    list, err := l.delegate.MapError(mapper)
+
+    return NewSynchronizedList(list), err
+}
+
+// ForEach performs the provided consumer function for each element of the SynchronizedList.
+func (l *synchronizedList) ForEach(consumer func(element interface{})) {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    l.delegate.ForEach(consumer)
+}
+
+// Stream returns a channel over which the elements of the SynchronizedList are sent in iteration order, which is closed
+// once every element has been sent. The elements are sent from a snapshot of the SynchronizedList, so it may be
+// modified before the channel is closed.
+func (l *synchronizedList) Stream() <-chan interface{} {
+    return collection.Stream(l, nil)
+}
+
+// ToSlice copies the elements of the SynchronizedList into the slice pointed to by the provided destination (e.g.
+// *[]string), replacing its contents. The returned error will be non-nil if the destination is not a non-nil pointer to
+// a slice, or if any element is not assignable to the element type of the slice.
+func (l *synchronizedList) ToSlice(dst interface{}) error {
+    return toSlice(l.Values(), dst)
+}
+
+// CopyTo copies as many elements of the SynchronizedList as fit into the provided slice, and returns the number copied.
+func (l *synchronizedList) CopyTo(dst []interface{}) int {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return l.delegate.CopyTo(dst)
+}
+
+// Size returns the number of elements in the SynchronizedList.
+func (l *synchronizedList) Size() int {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return l.delegate.Size()
+}
+
+// IsEmpty returns true if the SynchronizedList contains no elements, otherwise false is returned.
+func (l *synchronizedList) IsEmpty() bool {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return l.delegate.IsEmpty()
+}
+
+// Capacity returns the capacity of the delegate List if it is collection.Bounded, otherwise collection.Unbounded is
+// returned.
+func (l *synchronizedList) Capacity() int {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return collection.Capacity(l.delegate)
+}
+
+// IsFull returns true if the delegate List is collection.Bounded and has reached capacity, otherwise false is returned.
+func (l *synchronizedList) IsFull() bool {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return collection.IsFull(l.delegate)
+}
+
+// Clear removes all elements from the SynchronizedList.
+func (l *synchronizedList) Clear() {
+    l.mutex.Lock()
+    defer l.mutex.Unlock()
+
+    l.delegate.Clear()
+}
+
+// Contains returns true if an element equivalent to the provided element exists in the SynchronizedList, otherwise
+// false is returned.
+func (l *synchronizedList) Contains(element interface{}) bool {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return l.delegate.Contains(element)
+}
+
+// ContainsAll returns true if every element of the provided collection exists in the SynchronizedList, otherwise false
+// is returned. A nil or empty collection is contained by every SynchronizedList. The elements of the provided
+// collection are read before the SynchronizedList is locked.
+func (l *synchronizedList) ContainsAll(other collection.Collection) bool {
+    if other == nil {
+        return true
+    }
+
+    elements := NewArrayListOf(other.Values())
+
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return l.delegate.ContainsAll(elements)
+}
+
+// ContainsAllOf returns a map from each of the provided elements to true if it exists in the SynchronizedList,
+// otherwise false. Elements that are not hashable cannot be keys of the map, and are omitted.
+func (l *synchronizedList) ContainsAllOf(elements []interface{}) map[interface{}]bool {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return l.delegate.ContainsAllOf(elements)
+}
+
+// EqualsIgnoreOrder returns true if the provided List contains the same elements as the SynchronizedList with the same
+// number of occurrences, regardless of their positions. A nil List is not equal to any List.
+func (l *synchronizedList) EqualsIgnoreOrder(other List) bool {
+    return other != nil && collection.Equals(l, other)
+}
+
+// Values returns a slice containing the elements in the SynchronizedList in the iteration order.
+func (l *synchronizedList) Values() []interface{} {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return l.delegate.Values()
+}
+
+// Clone returns a new SynchronizedList wrapping a clone of the delegate List.
+func (l *synchronizedList) Clone() collection.Collection {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return NewSynchronizedList(l.delegate.Clone().(List))
+}
+
+// Iterator returns an Iterator positioned before the first element of the SynchronizedList. The Iterator traverses a
+// snapshot of the elements taken when the Iterator is created, so it is unaffected by concurrent modifications.
+func (l *synchronizedList) Iterator() collection.Iterator {
+    return newIndexIterator(NewArrayListOf(l.Values()))
+}
+
+// ReverseIterator returns an Iterator positioned after the last element of the SynchronizedList, which traverses the
+// elements from the last to the first. As with Iterator, the traversal is of a snapshot of the elements taken when the
+// Iterator is created.
+func (l *synchronizedList) ReverseIterator() collection.Iterator {
+    return newReverseIndexIterator(NewArrayListOf(l.Values()))
+}
+
+// String returns a string representation of the SynchronizedList in it's current state.
+func (l *synchronizedList) String() string {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return fmt.Sprintf("%v", l.delegate)
+}
+
+// StringQuoted returns a string representation of the SynchronizedList in which string elements are quoted and nil
+// elements are rendered as nil.
+func (l *synchronizedList) StringQuoted() string {
+    l.mutex.RLock()
+    defer l.mutex.RUnlock()
+
+    return l.delegate.StringQuoted()
+}
@@ -0,0 +1,67 @@
+package list
+
+import (
+    "sync"
+    "testing"
+)
+
+func TestSynchronizedList_Concurrent(t *testing.T) {
+    const numWriters, numAddsPerWriter = 8, 250
+
+    list := NewSynchronizedList(NewArrayList())
+
+    var wg sync.WaitGroup
+    for w := 0; w < numWriters; w++ {
+        wg.Add(2)
+
+        go func(w int) {
+            defer wg.Done()
+
+            for i := 0; i < numAddsPerWriter; i++ {
+                _ = list.Add(w * numAddsPerWriter + i)
+            }
+        }(w)
+
+        go func() {
+            defer wg.Done()
+
+            for i := 0; i < numAddsPerWriter; i++ {
+                if list.Size() > 0 {
+                    _, _ = list.ValueWithIndex(0)
+                }
+                _ = list.Contains(i)
+                list.ForEach(func(element interface{}) {})
+            }
+        }()
+    }
+    wg.Wait()
+
+    assertSize(t, list, numWriters * numAddsPerWriter)
+    for i := 0; i < numWriters * numAddsPerWriter; i++ {
+        assertContains(t, list, i, true)
+    }
+}
+
+func TestSynchronizedList_AddAllToSelf(t *testing.T) {
+    list := NewSynchronizedList(NewArrayListOf([]interface{}{ "samus", "yoshi" }))
+
+    assertError(t, list.AddAll(list), nil)
+    assertSize(t, list, 4)
+}
+
+func TestSynchronizedList_Functional(t *testing.T) {
+    list     := NewSynchronizedList(NewArrayListOf([]interface{}{ 1, 2, 3, 4 }))
+    filtered := list.Filter(func(element interface{}) bool { return element.(int) > 2 })
+    mapped   := list.Map(func(element interface{}) interface{} { return element.(int) * 10 })
+
+    if _, ok := filtered.(*synchronizedList); !ok {
+        t.Error("expected Filter to return a SynchronizedList")
+    }
+
+    if _, ok := mapped.(*synchronizedList); !ok {
+        t.Error("expected Map to return a SynchronizedList")
+    }
+
+    assertSize(t, filtered, 2)
+    assertContains(t, mapped, 40, true)
+}
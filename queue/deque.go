@@ -0,0 +1,169 @@
+package queue
+
+import (
+    "fmt"
+    "strings"
+
+    "github.com/2speed/go-collection"
+)
+
+const defaultDequeCapacity = 8
+
+// Deque defines the behavior for a double-ended queue whose elements may be inserted and removed at either end.
+type Deque interface {
+
+    // PushFront inserts the provided element at the front of the Deque.
+    PushFront(element interface{})
+
+    // PushBack inserts the provided element at the back of the Deque.
+    PushBack(element interface{})
+
+    // PopFront removes the element at the front of the Deque and returns it. The returned error will be
+    // collection.ErrorEmpty if the Deque contains no elements.
+    PopFront() (interface{}, error)
+
+    // PopBack removes the element at the back of the Deque and returns it. The returned error will be
+    // collection.ErrorEmpty if the Deque contains no elements.
+    PopBack() (interface{}, error)
+
+    // PeekFront returns the element at the front of the Deque without removing it. The returned error will be
+    // collection.ErrorEmpty if the Deque contains no elements.
+    PeekFront() (interface{}, error)
+
+    // PeekBack returns the element at the back of the Deque without removing it. The returned error will be
+    // collection.ErrorEmpty if the Deque contains no elements.
+    PeekBack() (interface{}, error)
+
+    // Size returns the number of elements in the Deque.
+    Size() int
+
+    // IsEmpty returns true if the Deque contains no elements, otherwise false is returned.
+    IsEmpty() bool
+}
+
+// deque maintains its elements in a ring buffer, where front is the position of the first element. The ring buffer
+// doubles in size when it is full, so each operation is O(1) amortized.
+type deque struct {
+    elements []interface{}
+    front    int
+    size     int
+}
+
+// NewDeque creates a new Deque.
+func NewDeque() Deque {
+    return &deque{ elements: make([]interface{}, defaultDequeCapacity) }
+}
+
+// PushFront inserts the provided element at the front of the Deque.
+func (d *deque) PushFront(element interface{}) {
+    d.growIfFull()
+
+    d.front = d.position(-1)
+    d.elements[d.front] = element
+    d.size++
+}
+
+// PushBack inserts the provided element at the back of the Deque.
+func (d *deque) PushBack(element interface{}) {
+    d.growIfFull()
+
+    d.elements[d.position(d.size)] = element
+    d.size++
+}
+
+// PopFront removes the element at the front of the Deque and returns it. The returned error will be
+// collection.ErrorEmpty if the Deque contains no elements.
+func (d *deque) PopFront() (interface{}, error) {
+    if d.IsEmpty() {
+        return nil, collection.ErrorEmpty
+    }
+
+    element := d.elements[d.front]
+
+    d.elements[d.front] = nil
+    d.front = d.position(1)
+    d.size--
+
+    return element, nil
+}
+
+// PopBack removes the element at the back of the Deque and returns it. The returned error will be
+// collection.ErrorEmpty if the Deque contains no elements.
+func (d *deque) PopBack() (interface{}, error) {
+    if d.IsEmpty() {
+        return nil, collection.ErrorEmpty
+    }
+
+    back    := d.position(d.size - 1)
+    element := d.elements[back]
+
+    d.elements[back] = nil
+    d.size--
+
+    return element, nil
+}
+
+// PeekFront returns the element at the front of the Deque without removing it. The returned error will be
+// collection.ErrorEmpty if the Deque contains no elements.
+func (d *deque) PeekFront() (interface{}, error) {
+    if d.IsEmpty() {
+        return nil, collection.ErrorEmpty
+    }
+
+    return d.elements[d.front], nil
+}
+
+// PeekBack returns the element at the back of the Deque without removing it. The returned error will be
+// collection.ErrorEmpty if the Deque contains no elements.
+func (d *deque) PeekBack() (interface{}, error) {
+    if d.IsEmpty() {
+        return nil, collection.ErrorEmpty
+    }
+
+    return d.elements[d.position(d.size - 1)], nil
+}
+
+// Size returns the number of elements in the Deque.
+func (d *deque) Size() int {
+    return d.size
+}
+
+// IsEmpty returns true if the Deque contains no elements, otherwise false is returned.
+func (d *deque) IsEmpty() bool {
+    return d.Size() == 0
+}
+
+// String returns a string representation of the Deque in it's current state, ordered from front to back.
+func (d *deque) String() string {
+    if d.Size() == 0 {
+        return "[]"
+    }
+
+    elements := make([]string, 0, d.Size())
+    for i := 0; i < d.size; i++ {
+        elements = append(elements, fmt.Sprintf("%v", d.elements[d.position(i)]))
+    }
+
+    return "[" + strings.Join(elements, ", ") + "]"
+}
+
+// position returns the index within the ring buffer of the element offset from the front of the Deque.
+func (d *deque) position(offset int) int {
+    n := len(d.elements)
+
+    return ((d.front + offset) % n + n) % n
+}
+
+func (d *deque) growIfFull() {
+    if d.size < len(d.elements) {
+        return
+    }
+
+    elements := make([]interface{}, len(d.elements) * 2)
+    for i := 0; i < d.size; i++ {
+        elements[i] = d.elements[d.position(i)]
+    }
+
+    d.elements = elements
+    d.front    = 0
+}
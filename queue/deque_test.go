@@ -0,0 +1,94 @@
+package queue
+
+import (
+    "fmt"
+    "testing"
+
+    "github.com/2speed/go-collection"
+)
+
+func TestDeque_AlternatingEnds(t *testing.T) {
+    deque := NewDeque()
+
+    deque.PushBack("samus")
+    deque.PushFront("piranha plant")
+    deque.PushBack("jigglypuff")
+    deque.PushFront("luffy")
+    assertContentEquals(t, deque, "[luffy, piranha plant, samus, jigglypuff]")
+
+    element, err := deque.PopBack()
+    assertError(t, err, nil)
+    assertValue(t, element, "jigglypuff")
+
+    element, err = deque.PopFront()
+    assertError(t, err, nil)
+    assertValue(t, element, "luffy")
+
+    element, err = deque.PeekFront()
+    assertError(t, err, nil)
+    assertValue(t, element, "piranha plant")
+
+    element, err = deque.PeekBack()
+    assertError(t, err, nil)
+    assertValue(t, element, "samus")
+
+    assertSize(t, deque.Size(), 2)
+}
+
+func TestDeque_Growth(t *testing.T) {
+    deque := NewDeque()
+    count := defaultDequeCapacity * 4
+
+    for i := 0; i < count; i++ {
+        if i % 2 == 0 {
+            deque.PushBack(i)
+        } else {
+            deque.PushFront(-i)
+        }
+    }
+    assertSize(t, deque.Size(), count)
+
+    for i := count - 1; i >= 0; i-- {
+        if i % 2 == 0 {
+            element, err := deque.PopBack()
+
+            assertError(t, err, nil)
+            assertValue(t, element, i)
+        } else {
+            element, err := deque.PopFront()
+
+            assertError(t, err, nil)
+            assertValue(t, element, -i)
+        }
+    }
+
+    if !deque.IsEmpty() {
+        t.Error("expected result to be true")
+    }
+}
+
+func TestDeque_Empty(t *testing.T) {
+    deque := NewDeque()
+
+    for name, operation := range map[string]func() (interface{}, error){
+        "PopFront":  deque.PopFront,
+        "PopBack":   deque.PopBack,
+        "PeekFront": deque.PeekFront,
+        "PeekBack":  deque.PeekBack,
+    } {
+        t.Run(name, func(t *testing.T) {
+            element, err := operation()
+
+            assertError(t, err, collection.ErrorEmpty)
+            assertValue(t, element, nil)
+        })
+    }
+}
+
+func assertContentEquals(t *testing.T, actual interface{}, expected string) {
+    t.Helper()
+
+    if s := fmt.Sprintf("%s", actual); s != expected {
+        t.Errorf("expected content of '%s', but found '%s'", expected, s)
+    }
+}
@@ -0,0 +1,31 @@
+package queue
+
+import "github.com/2speed/go-collection"
+
+// sliceIterator traverses a slice of elements.
+type sliceIterator struct {
+    elements []interface{}
+    index    int
+}
+
+func newSliceIterator(elements []interface{}) collection.Iterator {
+    return &sliceIterator{ elements: elements }
+}
+
+// HasNext returns true if the traversal has further elements, otherwise false is returned.
+func (i *sliceIterator) HasNext() bool {
+    return i.index < len(i.elements)
+}
+
+// Next advances the traversal and returns the next element. If the traversal has no further elements, the return value
+// will be nil.
+func (i *sliceIterator) Next() interface{} {
+    if !i.HasNext() {
+        return nil
+    }
+
+    element := i.elements[i.index]
+    i.index++
+
+    return element
+}
@@ -0,0 +1,286 @@
+package queue
+
+import (
+    "reflect"
+    "sort"
+
+    "github.com/2speed/go-collection"
+)
+
+// PriorityQueue defines the behavior for an Ordered Collection that supports efficient extraction of its lowest
+// element. The iteration order of a PriorityQueue is ascending as determined by the comparator it was created with.
+type PriorityQueue interface {
+    collection.Ordered
+
+    // RemoveFirst removes the element with the lowest position (PriorityQueue.Min()) from the PriorityQueue and returns
+    // it. If the PriorityQueue is empty (PriorityQueue.Size() == 0), the return value will be nil.
+    RemoveFirst() interface{}
+}
+
+// priorityQueue maintains its elements in a binary min-heap stored in a slice, where the children of the element at
+// index i are at indexes 2i + 1 and 2i + 2.
+type priorityQueue struct {
+    elements []interface{}
+    less     func(a, b interface{}) bool
+}
+
+// NewPriorityQueue creates a new PriorityQueue ordered by the provided comparator, which returns true if a is less
+// than b. Add, Remove, and RemoveFirst are O(log n), Min is O(1), and Max, Predecessor, and Successor are O(n).
+func NewPriorityQueue(less func(a, b interface{}) bool) PriorityQueue {
+    return &priorityQueue{
+        elements: make([]interface{}, 0),
+        less:     less,
+    }
+}
+
+// Add inserts the provided element into the PriorityQueue.
+func (q *priorityQueue) Add(element interface{}) error {
+    q.elements = append(q.elements, element)
+    q.siftUp(len(q.elements) - 1)
+
+    return nil
+}
+
+// AddAll inserts all elements from the provided Collection into the PriorityQueue.
+func (q *priorityQueue) AddAll(collection collection.Collection) error {
+    if collection != nil {
+        for _, v := range collection.Values() {
+            _ = q.Add(v)
+        }
+    }
+
+    return nil
+}
+
+// Remove removes the first occurrence (if any) of an element equivalent to the provided element. If an element was
+// removed, the return value will be true, otherwise false will be returned.
+func (q *priorityQueue) Remove(element interface{}) bool {
+    for i, v := range q.elements {
+        if reflect.DeepEqual(v, element) {
+            q.removeAt(i)
+
+            return true
+        }
+    }
+
+    return false
+}
+
+// RemoveAll removes every occurrence of each element of the provided collection from the PriorityQueue, and returns the
+// number of elements removed. A nil collection removes no elements.
+func (q *priorityQueue) RemoveAll(other collection.Collection) int {
+    return collection.RemoveAll(q, other)
+}
+
+// RetainAll removes every element from the PriorityQueue that does not exist in the provided collection, and returns
+// the number of elements removed. A nil collection is treated as an empty collection.
+func (q *priorityQueue) RetainAll(other collection.Collection) int {
+    return collection.RetainAll(q, other)
+}
+
+// RemoveFirst removes the element with the lowest position from the PriorityQueue and returns it. If the PriorityQueue
+// is empty (PriorityQueue.Size() == 0), the return value will be nil.
+func (q *priorityQueue) RemoveFirst() interface{} {
+    if q.IsEmpty() {
+        return nil
+    }
+
+    return q.removeAt(0)
+}
+
+// Size returns the number of elements in the PriorityQueue.
+func (q *priorityQueue) Size() int {
+    return len(q.elements)
+}
+
+// IsEmpty returns true if the PriorityQueue contains no elements, otherwise false is returned.
+func (q *priorityQueue) IsEmpty() bool {
+    return q.Size() == 0
+}
+
+// Clear removes all elements from the PriorityQueue.
+func (q *priorityQueue) Clear() {
+    for i := range q.elements {
+        q.elements[i] = nil
+    }
+    q.elements = q.elements[:0]
+}
+
+// Contains returns true if an element equivalent to the provided element exists in the PriorityQueue, otherwise false
+// is returned.
+func (q *priorityQueue) Contains(element interface{}) bool {
+    for _, v := range q.elements {
+        if reflect.DeepEqual(v, element) {
+            return true
+        }
+    }
+
+    return false
+}
+
+// ContainsAll returns true if every element of the provided collection exists in the PriorityQueue, otherwise false is
+// returned. A nil or empty collection is contained by every PriorityQueue.
+func (q *priorityQueue) ContainsAll(other collection.Collection) bool {
+    return collection.ContainsAll(q, other)
+}
+
+// Values returns a slice containing the elements in the PriorityQueue in ascending order.
+func (q *priorityQueue) Values() []interface{} {
+    elements := make([]interface{}, len(q.elements))
+    copy(elements, q.elements)
+
+    sort.SliceStable(elements, func(i, j int) bool { return q.less(elements[i], elements[j]) })
+
+    return elements
+}
+
+// Clone returns a new PriorityQueue with the same comparator containing the elements of the PriorityQueue. The heap is
+// copied as is, and the elements themselves are not copied.
+func (q *priorityQueue) Clone() collection.Collection {
+    elements := make([]interface{}, len(q.elements))
+    copy(elements, q.elements)
+
+    return &priorityQueue{ elements: elements, less: q.less }
+}
+
+// Iterator returns an Iterator positioned before the lowest element of the PriorityQueue. Since the heap of the
+// PriorityQueue is not maintained in ascending order, the Iterator traverses a sorted snapshot of the elements taken
+// when the Iterator is created.
+func (q *priorityQueue) Iterator() collection.Iterator {
+    return newSliceIterator(q.Values())
+}
+
+// Min returns the lowest element in the PriorityQueue, or nil if the PriorityQueue is empty.
+func (q *priorityQueue) Min() interface{} {
+    if q.IsEmpty() {
+        return nil
+    }
+
+    return q.elements[0]
+}
+
+// Max returns the highest element in the PriorityQueue, or nil if the PriorityQueue is empty.
+func (q *priorityQueue) Max() interface{} {
+    var max interface{}
+    for i, v := range q.elements {
+        if i == 0 || q.less(max, v) {
+            max = v
+        }
+    }
+
+    return max
+}
+
+// Predecessor returns the highest element (if any) from the PriorityQueue that is less than the provided element.
+func (q *priorityQueue) Predecessor(element interface{}) interface{} {
+    var predecessor interface{}
+    found := false
+    for _, v := range q.elements {
+        if q.less(v, element) && (!found || q.less(predecessor, v)) {
+            predecessor = v
+            found       = true
+        }
+    }
+
+    return predecessor
+}
+
+// Successor returns the lowest element (if any) from the PriorityQueue that is greater than the provided element.
+func (q *priorityQueue) Successor(element interface{}) interface{} {
+    var successor interface{}
+    found := false
+    for _, v := range q.elements {
+        if q.less(element, v) && (!found || q.less(v, successor)) {
+            successor = v
+            found     = true
+        }
+    }
+
+    return successor
+}
+
+// Floor returns the highest element (if any) from the PriorityQueue that is less than or equal to the provided element.
+func (q *priorityQueue) Floor(element interface{}) interface{} {
+    var floor interface{}
+    found := false
+    for _, v := range q.elements {
+        if !q.less(element, v) && (!found || q.less(floor, v)) {
+            floor = v
+            found = true
+        }
+    }
+
+    return floor
+}
+
+// Ceiling returns the lowest element (if any) from the PriorityQueue that is greater than or equal to the provided
+// element.
+func (q *priorityQueue) Ceiling(element interface{}) interface{} {
+    var ceiling interface{}
+    found := false
+    for _, v := range q.elements {
+        if !q.less(v, element) && (!found || q.less(v, ceiling)) {
+            ceiling = v
+            found   = true
+        }
+    }
+
+    return ceiling
+}
+
+// String returns a string representation of the PriorityQueue in it's current state.
+func (q *priorityQueue) String() string {
+    return collection.Format(q)
+}
+
+func (q *priorityQueue) removeAt(index int) interface{} {
+    last    := len(q.elements) - 1
+    element := q.elements[index]
+
+    q.elements[index] = q.elements[last]
+    q.elements[last]  = nil
+    q.elements        = q.elements[:last]
+
+    if index < last {
+        q.siftDown(index)
+        q.siftUp(index)
+    }
+
+    return element
+}
+
+func (q *priorityQueue) siftUp(index int) {
+    for index > 0 {
+        parent := (index - 1) / 2
+        if !q.less(q.elements[index], q.elements[parent]) {
+            return
+        }
+
+        q.elements[index], q.elements[parent] = q.elements[parent], q.elements[index]
+        index = parent
+    }
+}
+
+func (q *priorityQueue) siftDown(index int) {
+    n := len(q.elements)
+    for {
+        smallest := index
+        left     := 2 * index + 1
+        right    := left + 1
+
+        if left < n && q.less(q.elements[left], q.elements[smallest]) {
+            smallest = left
+        }
+
+        if right < n && q.less(q.elements[right], q.elements[smallest]) {
+            smallest = right
+        }
+
+        if smallest == index {
+            return
+        }
+
+        q.elements[index], q.elements[smallest] = q.elements[smallest], q.elements[index]
+        index = smallest
+    }
+}
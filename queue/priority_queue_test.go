@@ -0,0 +1,77 @@
+package queue
+
+import (
+    "math/rand"
+    "testing"
+
+    "github.com/2speed/go-collection"
+)
+
+func byInt(a, b interface{}) bool {
+    return a.(int) < b.(int)
+}
+
+func TestPriorityQueue_RemoveFirst(t *testing.T) {
+    queue  := NewPriorityQueue(byInt)
+    random := rand.New(rand.NewSource(42))
+
+    for i := 0; i < 100; i++ {
+        assertError(t, queue.Add(random.Intn(50)), nil)
+    }
+    assertSize(t, queue.Size(), 100)
+
+    previous := -1
+    for !queue.IsEmpty() {
+        element := queue.RemoveFirst().(int)
+        if element < previous {
+            t.Errorf("expected elements in ascending order, but found '%d' after '%d'", element, previous)
+        }
+        previous = element
+    }
+
+    if queue.RemoveFirst() != nil {
+        t.Error("expected nil from RemoveFirst on empty queue")
+    }
+}
+
+func TestPriorityQueue_Ordered(t *testing.T) {
+    var queue collection.Ordered = NewPriorityQueue(byInt)
+
+    for _, v := range []int{ 40, 10, 30, 50, 20 } {
+        _ = queue.Add(v)
+    }
+
+    assertValue(t, queue.Min(), 10)
+    assertValue(t, queue.Max(), 50)
+    assertValue(t, queue.Predecessor(30), 20)
+    assertValue(t, queue.Predecessor(35), 30)
+    assertValue(t, queue.Predecessor(10), nil)
+    assertValue(t, queue.Successor(30), 40)
+    assertValue(t, queue.Successor(50), nil)
+    assertValue(t, queue.Floor(30), 30)
+    assertValue(t, queue.Floor(35), 30)
+    assertValue(t, queue.Floor(5), nil)
+    assertValue(t, queue.Ceiling(30), 30)
+    assertValue(t, queue.Ceiling(35), 40)
+    assertValue(t, queue.Ceiling(55), nil)
+    assertContentEquals(t, queue, "[10, 20, 30, 40, 50]")
+
+    iterator := queue.Iterator()
+    for _, expected := range []int{ 10, 20, 30, 40, 50 } {
+        assertValue(t, iterator.Next(), expected)
+    }
+    assertValue(t, iterator.HasNext(), false)
+
+    if !queue.Remove(10) {
+        t.Error("expected result to be true")
+    }
+    assertValue(t, queue.Min(), 20)
+
+    if queue.Contains(10) {
+        t.Error("expected result to be false")
+    }
+
+    queue.Clear()
+    assertValue(t, queue.Min(), nil)
+    assertValue(t, queue.Max(), nil)
+}
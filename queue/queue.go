@@ -0,0 +1,79 @@
+package queue
+
+import (
+    "fmt"
+
+    "github.com/2speed/go-collection"
+    "github.com/2speed/go-collection/list"
+)
+
+// Queue defines the behavior for a first-in-first-out (FIFO) container of elements.
+type Queue interface {
+
+    // Enqueue inserts the provided element at the back of the Queue.
+    Enqueue(element interface{})
+
+    // Dequeue removes the element at the front of the Queue and returns it. The returned error will be
+    // collection.ErrorEmpty if the Queue contains no elements.
+    Dequeue() (interface{}, error)
+
+    // Peek returns the element at the front of the Queue without removing it. The returned error will be
+    // collection.ErrorEmpty if the Queue contains no elements.
+    Peek() (interface{}, error)
+
+    // Size returns the number of elements in the Queue.
+    Size() int
+
+    // IsEmpty returns true if the Queue contains no elements, otherwise false is returned.
+    IsEmpty() bool
+}
+
+type queue struct {
+    elements list.List
+}
+
+// NewQueue creates a new Queue whose elements are maintained by a LinkedList, so that both Enqueue and Dequeue are
+// O(1).
+func NewQueue() Queue {
+    return &queue{ elements: list.NewLinkedList() }
+}
+
+// Enqueue inserts the provided element at the back of the Queue.
+func (q *queue) Enqueue(element interface{}) {
+    _ = q.elements.AddLast(element)
+}
+
+// Dequeue removes the element at the front of the Queue and returns it. The returned error will be
+// collection.ErrorEmpty if the Queue contains no elements.
+func (q *queue) Dequeue() (interface{}, error) {
+    if q.IsEmpty() {
+        return nil, collection.ErrorEmpty
+    }
+
+    return q.elements.RemoveFirst(), nil
+}
+
+// Peek returns the element at the front of the Queue without removing it. The returned error will be
+// collection.ErrorEmpty if the Queue contains no elements.
+func (q *queue) Peek() (interface{}, error) {
+    if q.IsEmpty() {
+        return nil, collection.ErrorEmpty
+    }
+
+    return q.elements.ValueWithIndex(0)
+}
+
+// Size returns the number of elements in the Queue.
+func (q *queue) Size() int {
+    return q.elements.Size()
+}
+
+// IsEmpty returns true if the Queue contains no elements, otherwise false is returned.
+func (q *queue) IsEmpty() bool {
+    return q.elements.IsEmpty()
+}
+
+// String returns a string representation of the Queue in it's current state, ordered from front to back.
+func (q *queue) String() string {
+    return fmt.Sprintf("%v", q.elements)
+}
@@ -0,0 +1,84 @@
+package queue
+
+import (
+    "testing"
+
+    "github.com/2speed/go-collection"
+)
+
+func TestQueue_EnqueueDequeue(t *testing.T) {
+    queue  := NewQueue()
+    values := []interface{}{ "piranha plant", "samus", "jigglypuff", "r.o.b." }
+
+    for _, v := range values {
+        queue.Enqueue(v)
+    }
+    assertSize(t, queue.Size(), 4)
+
+    for _, v := range values {
+        element, err := queue.Dequeue()
+
+        assertError(t, err, nil)
+        assertValue(t, element, v)
+    }
+
+    if !queue.IsEmpty() {
+        t.Error("expected result to be true")
+    }
+}
+
+func TestQueue_Peek(t *testing.T) {
+    queue := NewQueue()
+    queue.Enqueue("mega man")
+    queue.Enqueue("yoshi")
+
+    for i := 0; i < 2; i++ {
+        element, err := queue.Peek()
+
+        assertError(t, err, nil)
+        assertValue(t, element, "mega man")
+        assertSize(t, queue.Size(), 2)
+    }
+}
+
+func TestQueue_Empty(t *testing.T) {
+    queue := NewQueue()
+
+    t.Run("Dequeue", func(t *testing.T) {
+        element, err := queue.Dequeue()
+
+        assertError(t, err, collection.ErrorEmpty)
+        assertValue(t, element, nil)
+    })
+
+    t.Run("Peek", func(t *testing.T) {
+        element, err := queue.Peek()
+
+        assertError(t, err, collection.ErrorEmpty)
+        assertValue(t, element, nil)
+    })
+}
+
+func assertError(t *testing.T, actual error, expected error) {
+    t.Helper()
+
+    if actual != expected {
+        t.Errorf("expected error '%s', but found '%s'", expected, actual)
+    }
+}
+
+func assertSize(t *testing.T, actual int, expected int) {
+    t.Helper()
+
+    if actual != expected {
+        t.Errorf("expected size of '%d', but found '%d'", expected, actual)
+    }
+}
+
+func assertValue(t *testing.T, actual interface{}, expected interface{}) {
+    t.Helper()
+
+    if actual != expected {
+        t.Errorf("expected value '%v', but found '%v'", expected, actual)
+    }
+}
@@ -0,0 +1,79 @@
+package stack
+
+import (
+    "fmt"
+
+    "github.com/2speed/go-collection"
+    "github.com/2speed/go-collection/list"
+)
+
+// Stack defines the behavior for a last-in-first-out (LIFO) container of elements.
+type Stack interface {
+
+    // Push inserts the provided element at the top of the Stack.
+    Push(element interface{})
+
+    // Pop removes the element at the top of the Stack and returns it. The returned error will be
+    // collection.ErrorEmpty if the Stack contains no elements.
+    Pop() (interface{}, error)
+
+    // Peek returns the element at the top of the Stack without removing it. The returned error will be
+    // collection.ErrorEmpty if the Stack contains no elements.
+    Peek() (interface{}, error)
+
+    // Size returns the number of elements in the Stack.
+    Size() int
+
+    // IsEmpty returns true if the Stack contains no elements, otherwise false is returned.
+    IsEmpty() bool
+}
+
+type stack struct {
+    elements list.List
+}
+
+// NewStack creates a new Stack whose elements are maintained by an ArrayList, where the top of the Stack is the end of
+// the ArrayList.
+func NewStack() Stack {
+    return &stack{ elements: list.NewArrayList() }
+}
+
+// Push inserts the provided element at the top of the Stack.
+func (s *stack) Push(element interface{}) {
+    _ = s.elements.AddLast(element)
+}
+
+// Pop removes the element at the top of the Stack and returns it. The returned error will be collection.ErrorEmpty if
+// the Stack contains no elements.
+func (s *stack) Pop() (interface{}, error) {
+    if s.IsEmpty() {
+        return nil, collection.ErrorEmpty
+    }
+
+    return s.elements.RemoveLast(), nil
+}
+
+// Peek returns the element at the top of the Stack without removing it. The returned error will be
+// collection.ErrorEmpty if the Stack contains no elements.
+func (s *stack) Peek() (interface{}, error) {
+    if s.IsEmpty() {
+        return nil, collection.ErrorEmpty
+    }
+
+    return s.elements.ValueWithIndex(s.Size() - 1)
+}
+
+// Size returns the number of elements in the Stack.
+func (s *stack) Size() int {
+    return s.elements.Size()
+}
+
+// IsEmpty returns true if the Stack contains no elements, otherwise false is returned.
+func (s *stack) IsEmpty() bool {
+    return s.elements.IsEmpty()
+}
+
+// String returns a string representation of the Stack in it's current state, ordered from bottom to top.
+func (s *stack) String() string {
+    return fmt.Sprintf("%v", s.elements)
+}
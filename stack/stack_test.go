@@ -0,0 +1,83 @@
+package stack
+
+import (
+    "testing"
+
+    "github.com/2speed/go-collection"
+)
+
+func TestStack_PushPop(t *testing.T) {
+    stack  := NewStack()
+    values := []interface{}{ "piranha plant", "samus", "jigglypuff", "r.o.b." }
+
+    for _, v := range values {
+        stack.Push(v)
+    }
+    assertSize(t, stack, 4)
+
+    for i := len(values) - 1; i >= 0; i-- {
+        element, err := stack.Pop()
+
+        assertError(t, err, nil)
+        assertValue(t, element, values[i])
+    }
+
+    if !stack.IsEmpty() {
+        t.Error("expected result to be true")
+    }
+}
+
+func TestStack_Peek(t *testing.T) {
+    stack := NewStack()
+    stack.Push("mega man")
+    stack.Push("yoshi")
+
+    element, err := stack.Peek()
+
+    assertError(t, err, nil)
+    assertValue(t, element, "yoshi")
+    assertSize(t, stack, 2)
+}
+
+func TestStack_Empty(t *testing.T) {
+    stack := NewStack()
+
+    t.Run("Pop", func(t *testing.T) {
+        element, err := stack.Pop()
+
+        assertError(t, err, collection.ErrorEmpty)
+        assertValue(t, element, nil)
+    })
+
+    t.Run("Peek", func(t *testing.T) {
+        element, err := stack.Peek()
+
+        assertError(t, err, collection.ErrorEmpty)
+        assertValue(t, element, nil)
+    })
+}
+
+func assertError(t *testing.T, actual error, expected error) {
+    t.Helper()
+
+    if actual != expected {
+        t.Errorf("expected error '%s', but found '%s'", expected, actual)
+    }
+}
+
+func assertSize(t *testing.T, stack Stack, expected int) {
+    t.Helper()
+
+    actual := stack.Size()
+    if actual != expected {
+        t.Errorf("expected size of '%d', but found '%d'", expected, actual)
+    }
+}
+
+func assertValue(t *testing.T, actual interface{}, expected interface{}) {
+    t.Helper()
+
+    if actual != expected {
+        t.Errorf("expected value '%v', but found '%v'", expected, actual)
+    }
+}
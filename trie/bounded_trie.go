@@ -0,0 +1,153 @@
+package trie
+
+import "github.com/2speed/go-collection"
+
+// BoundedTrie defines the behavior for a Trie that holds at most a fixed number of elements. Once the BoundedTrie has
+// reached capacity, inserting further elements returns collection.ErrorCapacityReached and leaves the BoundedTrie
+// unchanged. The capacity of the BoundedTrie (i.e. BoundedTrie.Capacity()) is the maximum number of elements, rather
+// than the alphabet size it was created with.
+type BoundedTrie interface {
+    Trie
+    collection.Bounded
+}
+
+type boundedTrie struct {
+    *trie
+
+    maxElements int
+}
+
+// NewBoundedTrie creates a new BoundedTrie that holds at most the provided maximum number of elements. The capacity is
+// used to set the base (or range of digits) used by the StringDigitizer for the trie.
+func NewBoundedTrie(capacity int, maxElements int) BoundedTrie {
+    return NewBoundedTrieWithDigitizer(NewStringDigitizer(capacity), maxElements)
+}
+
+// NewBoundedTrieWithDigitizer creates a new BoundedTrie that uses the provided Digitizer, and holds at most the provided
+// maximum number of elements. A negative maximum is treated as 0.
+func NewBoundedTrieWithDigitizer(digitizer Digitizer, maxElements int) BoundedTrie {
+    if maxElements < 0 {
+        maxElements = 0
+    }
+
+    bt := &boundedTrie{ trie: newTrieWithDigitizer(digitizer), maxElements: maxElements }
+    bt.operations = bt
+
+    return bt
+}
+
+// Add inserts the provided element into the BoundedTrie. The returned error will be collection.ErrorCapacityReached if
+// the BoundedTrie has reached capacity.
+func (bt *boundedTrie) Add(element interface{}) error {
+    if bt.IsFull() {
+        return collection.ErrorCapacityReached
+    }
+
+    return bt.trie.Add(element)
+}
+
+// AddWithPosition inserts the provided element into the BoundedTrie, and returns the position of the element in the
+// iteration order. The returned error will be collection.ErrorCapacityReached if the BoundedTrie has reached capacity.
+func (bt *boundedTrie) AddWithPosition(element interface{}) (int, error) {
+    if bt.IsFull() {
+        return collection.ElementNotFound, collection.ErrorCapacityReached
+    }
+
+    return bt.trie.AddWithPosition(element)
+}
+
+// AddAll inserts all elements from the provided collection into the BoundedTrie. Insertion stops at the first element
+// that cannot be inserted, including once the BoundedTrie has reached capacity, in which case the elements inserted
+// before the failure are removed so that the BoundedTrie is left unchanged.
+func (bt *boundedTrie) AddAll(collection collection.Collection) error {
+    if collection == nil {
+        return nil
+    }
+
+    values := collection.Values()
+    for i, v := range values {
+        if err := bt.Add(v); err != nil {
+            for j := i - 1; j >= 0; j-- {
+                bt.Remove(values[j])
+            }
+
+            return err
+        }
+    }
+
+    return nil
+}
+
+// Merge inserts the elements of the provided Trie that do not already exist in the BoundedTrie (see Trie.Merge). The
+// returned error will be collection.ErrorCapacityReached if the elements would exceed the capacity of the BoundedTrie,
+// in which case no elements are inserted.
+func (bt *boundedTrie) Merge(other Trie) error {
+    if err := bt.checkMergeable(other); err != nil || other == nil {
+        return err
+    }
+
+    added := 0
+    for _, element := range other.Values() {
+        if !bt.Contains(element) {
+            added++
+        }
+    }
+
+    if added > bt.maxElements - bt.Size() {
+        return collection.ErrorCapacityReached
+    }
+
+    return bt.trie.Merge(other)
+}
+
+// BulkAdd inserts all of the provided elements into the BoundedTrie (see Trie.BulkAdd). The returned error will be
+// collection.ErrorCapacityReached if the elements would exceed the capacity of the BoundedTrie, in which case no
+// elements are inserted.
+func (bt *boundedTrie) BulkAdd(elements []interface{}) error {
+    if len(elements) > bt.maxElements - bt.Size() {
+        return collection.ErrorCapacityReached
+    }
+
+    return bt.trie.BulkAdd(elements)
+}
+
+// Capacity returns the maximum number of elements the BoundedTrie can hold.
+func (bt *boundedTrie) Capacity() int {
+    return bt.maxElements
+}
+
+// IsFull returns true if the BoundedTrie holds as many elements as its capacity, otherwise false is returned.
+func (bt *boundedTrie) IsFull() bool {
+    return bt.Size() >= bt.maxElements
+}
+
+// Clone returns a new BoundedTrie with the same Digitizer and capacity containing the elements of the BoundedTrie.
+func (bt *boundedTrie) Clone() collection.Collection {
+    clone := NewBoundedTrieWithDigitizer(bt.digitizer, bt.maxElements).(*boundedTrie)
+    bt.copyTo(clone.trie)
+
+    return clone
+}
+
+// UnmarshalBinary replaces the elements of the BoundedTrie with the elements decoded from the provided data (see
+// Trie.UnmarshalBinary). The returned error will be collection.ErrorCapacityReached if the decoded elements exceed the
+// capacity of the BoundedTrie, in which case the BoundedTrie is left empty.
+func (bt *boundedTrie) UnmarshalBinary(data []byte) error {
+    if err := bt.trie.UnmarshalBinary(data); err != nil {
+        return err
+    }
+
+    if bt.Size() > bt.maxElements {
+        bt.Clear()
+
+        return collection.ErrorCapacityReached
+    }
+
+    return nil
+}
+
+// GobDecode replaces the elements of the BoundedTrie with the elements decoded from the provided data in the same
+// manner as UnmarshalBinary.
+func (bt *boundedTrie) GobDecode(data []byte) error {
+    return bt.UnmarshalBinary(data)
+}
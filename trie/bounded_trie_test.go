@@ -0,0 +1,66 @@
+package trie
+
+import (
+    "testing"
+
+    "github.com/2speed/go-collection"
+    "github.com/2speed/go-collection/list"
+)
+
+func TestBoundedTrie_Add(t *testing.T) {
+    trie := NewBoundedTrie(26, 3)
+
+    for _, element := range []string{ "samus", "luffy", "yoshi" } {
+        assertError(t, trie.Add(element), nil)
+    }
+    assertSize(t, trie, 3)
+
+    if !trie.IsFull() || collection.Capacity(trie) != 3 {
+        t.Errorf("expected a full trie with capacity of '3', but found capacity of '%d'", trie.Capacity())
+    }
+
+    assertError(t, trie.Add("kirby"), collection.ErrorCapacityReached)
+    assertSize(t, trie, 3)
+    assertContentEquals(t, trie, "[luffy, samus, yoshi]")
+
+    trie.Remove("luffy")
+    assertError(t, trie.Add("kirby"), nil)
+    assertContentEquals(t, trie, "[kirby, samus, yoshi]")
+}
+
+func TestBoundedTrie_AddAll(t *testing.T) {
+    trie := NewBoundedTrie(26, 4)
+    assertError(t, trie.Add("mario"), nil)
+
+    err := trie.AddAll(list.NewArrayListOf([]interface{}{ "samus", "luffy", "yoshi", "kirby" }))
+    assertError(t, err, collection.ErrorCapacityReached)
+    assertContentEquals(t, trie, "[mario]")
+
+    assertError(t, trie.AddAll(list.NewArrayListOf([]interface{}{ "samus", "luffy", "yoshi" })), nil)
+    assertSize(t, trie, 4)
+
+    t.Run("BulkAdd", func(t *testing.T) {
+        trie := NewBoundedTrie(26, 2)
+
+        assertError(t, trie.BulkAdd([]interface{}{ "samus", "luffy", "yoshi" }), collection.ErrorCapacityReached)
+        assertSize(t, trie, 0)
+
+        assertError(t, trie.BulkAdd([]interface{}{ "samus", "luffy" }), nil)
+        assertContentEquals(t, trie, "[luffy, samus]")
+    })
+}
+
+func TestBoundedTrie_Clone(t *testing.T) {
+    source := NewBoundedTrie(26, 2)
+    _ = source.Add("samus")
+
+    clone := source.Clone().(BoundedTrie)
+    assertError(t, clone.Add("luffy"), nil)
+    assertError(t, clone.Add("yoshi"), collection.ErrorCapacityReached)
+    assertContentEquals(t, source, "[samus]")
+
+    data, _ := clone.MarshalBinary()
+    target  := NewBoundedTrie(26, 1)
+    assertError(t, target.UnmarshalBinary(data), collection.ErrorCapacityReached)
+    assertSize(t, target, 0)
+}
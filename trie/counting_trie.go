@@ -0,0 +1,239 @@
+package trie
+
+import (
+    "github.com/2speed/go-collection"
+    "github.com/pkg/errors"
+)
+
+// CountingTrie defines the behavior for a Trie that permits duplicate elements by counting the occurrences of each of
+// its elements. The size and iteration order of a CountingTrie consider each distinct element once.
+type CountingTrie interface {
+    Trie
+
+    // Count returns the number of occurrences of the provided element in the CountingTrie, or 0 if the element is not
+    // found in the CountingTrie.
+    Count(element interface{}) int
+}
+
+type countingTrie struct {
+    *trie
+}
+
+// countingLeafNode is a LeafNode that carries the number of occurrences of its element.
+type countingLeafNode struct {
+    LeafNode
+
+    count int
+}
+
+// NewCountingTrie creates a new CountingTrie with the provided capacity. The capacity is used to set the base (or range
+// of digits) used by the StringDigitizer for the trie.
+func NewCountingTrie(capacity int) CountingTrie {
+    return NewCountingTrieWithDigitizer(NewStringDigitizer(capacity))
+}
+
+// NewCountingTrieWithDigitizer creates a new CountingTrie that uses the provided Digitizer.
+func NewCountingTrieWithDigitizer(digitizer Digitizer) CountingTrie {
+    ct := &countingTrie{ trie: newTrieWithDigitizer(digitizer) }
+    ct.operations = ct
+
+    return ct
+}
+
+// Add inserts the provided element into the CountingTrie. If the element already exists in the CountingTrie, its count
+// is incremented instead. The returned error will be non-nil if the element is not accepted by the Digitizer of the
+// CountingTrie.
+func (ct *countingTrie) Add(element interface{}) error {
+    if leafNode := ct.leafNodeOf(element); leafNode != nil {
+        leafNode.count++
+
+        return nil
+    }
+
+    _, err := ct.insert(element)
+
+    return err
+}
+
+// AddWithPosition inserts an occurrence of the provided element into the CountingTrie as with Add, and returns the
+// position of the element in the iteration order. Adding another occurrence of an element leaves its position unchanged.
+func (ct *countingTrie) AddWithPosition(element interface{}) (int, error) {
+    if leafNode := ct.leafNodeOf(element); leafNode != nil {
+        leafNode.count++
+
+        return positionOf(leafNode), nil
+    }
+
+    return ct.trie.AddWithPosition(element)
+}
+
+// AddAll inserts all elements from the provided collection into the CountingTrie. The returned error will be non-nil
+// if any element is not accepted by the Digitizer of the CountingTrie, in which case the occurrences added before the
+// failure are removed so that the CountingTrie is left unchanged.
+func (ct *countingTrie) AddAll(collection collection.Collection) error {
+    if collection == nil {
+        return nil
+    }
+
+    return ct.BulkAdd(collection.Values())
+}
+
+// BulkAdd inserts all of the provided elements into the CountingTrie, incrementing the count of each element that
+// already exists. The returned error will be non-nil if any element is not accepted by the Digitizer of the
+// CountingTrie, in which case the occurrences added before the failure are removed so that the CountingTrie is left
+// unchanged.
+func (ct *countingTrie) BulkAdd(elements []interface{}) error {
+    for i, v := range elements {
+        if err := ct.Add(v); err != nil {
+            for j := i - 1; j >= 0; j-- {
+                ct.Remove(elements[j])
+            }
+
+            return err
+        }
+    }
+
+    return nil
+}
+
+// Remove removes one occurrence of the provided element from the CountingTrie. The element is only unlinked from the
+// CountingTrie once its count reaches 0. If an occurrence was removed, the return value will be true, otherwise false
+// will be returned.
+func (ct *countingTrie) Remove(element interface{}) bool {
+    if leafNode := ct.leafNodeOf(element); leafNode != nil && leafNode.count > 1 {
+        leafNode.count--
+
+        return true
+    }
+
+    return ct.trie.Remove(element)
+}
+
+// RemoveMin removes one occurrence of the element returned by Min from the CountingTrie and returns it. The element is
+// only unlinked from the CountingTrie once its count reaches 0. If the CountingTrie is empty, the return value will be
+// nil.
+func (ct *countingTrie) RemoveMin() interface{} {
+    if ct.IsEmpty() {
+        return nil
+    }
+
+    return ct.removeOccurrence(ct.head.Next())
+}
+
+// RemoveMax removes one occurrence of the element returned by Max from the CountingTrie and returns it. The element is
+// only unlinked from the CountingTrie once its count reaches 0. If the CountingTrie is empty, the return value will be
+// nil.
+func (ct *countingTrie) RemoveMax() interface{} {
+    if ct.IsEmpty() {
+        return nil
+    }
+
+    return ct.removeOccurrence(ct.tail.Previous())
+}
+
+// RemoveAll removes every occurrence of each element of the provided collection from the CountingTrie, and returns the
+// number of occurrences removed. A nil collection removes no elements.
+func (ct *countingTrie) RemoveAll(other collection.Collection) int {
+    return collection.RemoveAll(ct, other)
+}
+
+// RetainAll removes every element from the CountingTrie that does not exist in the provided collection, and returns the
+// number of elements removed. A nil collection is treated as an empty collection.
+func (ct *countingTrie) RetainAll(other collection.Collection) int {
+    return collection.RetainAll(ct, other)
+}
+
+// Count returns the number of occurrences of the provided element in the CountingTrie, or 0 if the element is not found
+// in the CountingTrie.
+func (ct *countingTrie) Count(element interface{}) int {
+    if leafNode := ct.leafNodeOf(element); leafNode != nil {
+        return leafNode.count
+    }
+
+    return 0
+}
+
+// Clone returns a new CountingTrie with the same Digitizer containing the elements of the CountingTrie along with their
+// counts.
+func (ct *countingTrie) Clone() collection.Collection {
+    clone    := NewCountingTrieWithDigitizer(ct.digitizer).(*countingTrie)
+    iterator := newIterator(ct.trie, ct.head)
+
+    clone.sparse = ct.sparse
+    for iterator.advance() {
+        _, _ = clone.insertLeafNode(newCountingLeafNode(iterator.get(), countOf(iterator.pointer)))
+    }
+
+    return clone
+}
+
+// createLeafNode returns a new leaf node with a count of 1 for an element inserted into the CountingTrie.
+func (ct *countingTrie) createLeafNode() LeafNode {
+    return newCountingLeafNode(nil, 1)
+}
+
+// dataOf returns the count of the provided leaf node, which is encoded along with its element.
+func (ct *countingTrie) dataOf(leafNode LeafNode) interface{} {
+    return countOf(leafNode)
+}
+
+// leafNodeWith returns a new leaf node for the provided element with the provided decoded count. The returned error will
+// be non-nil if the count is not a positive int.
+func (ct *countingTrie) leafNodeWith(element interface{}, data interface{}) (LeafNode, error) {
+    count, ok := data.(int)
+    if !ok || count < 1 {
+        return nil, errors.Errorf("decoded count is not a positive int: %v", data)
+    }
+
+    return newCountingLeafNode(element, count), nil
+}
+
+// leafNodeOf returns the leaf node of the provided element, or nil if the element is not found in the CountingTrie.
+func (ct *countingTrie) leafNodeOf(element interface{}) *countingLeafNode {
+    if ct.IsEmpty() || !ct.accepts(element) {
+        return nil
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    if ct.operations.find(element, sctx) == Matched {
+        if leafNode, ok := sctx.pointer.(*countingLeafNode); ok {
+            return leafNode
+        }
+    }
+
+    return nil
+}
+
+// removeOccurrence removes one occurrence of the element of the provided leaf node, and returns the element.
+func (ct *countingTrie) removeOccurrence(leafNode LeafNode) interface{} {
+    if counted, ok := leafNode.(*countingLeafNode); ok && counted.count > 1 {
+        counted.count--
+
+        return counted.Value()
+    }
+
+    return ct.removeLeafNode(leafNode)
+}
+
+func newCountingLeafNode(element interface{}, count int) *countingLeafNode {
+    leafNode := &countingLeafNode{ LeafNode: newLeafNode(), count: count }
+    leafNode.SetValue(element)
+
+    return leafNode
+}
+
+// AddAfter links the countingLeafNode into the iteration order after the provided leaf node.
+func (l *countingLeafNode) AddAfter(leafNode LeafNode) {
+    linkAfter(l, leafNode)
+}
+
+// countOf returns the count of the provided leaf node, or 1 if the leaf node does not carry a count.
+func countOf(leafNode LeafNode) int {
+    if counted, ok := leafNode.(*countingLeafNode); ok {
+        return counted.count
+    }
+
+    return 1
+}
@@ -0,0 +1,129 @@
+package trie
+
+import (
+    "testing"
+
+    "github.com/2speed/go-collection/list"
+)
+
+func TestCountingTrie_Add(t *testing.T) {
+    trie   := NewCountingTrie(26)
+    values := []interface{}{ "apple", "banana", "apple", "cherry", "apple", "banana" }
+    err    := trie.AddAll(list.NewArrayListOf(values))
+
+    assertError(t, err, nil)
+    assertSize(t, trie, 3)
+    assertContentEquals(t, trie, "[apple, banana, cherry]")
+
+    for element, expected := range map[string]int{ "apple": 3, "banana": 2, "cherry": 1, "app": 0, "durian": 0 } {
+        if actual := trie.Count(element); actual != expected {
+            t.Errorf("expected count of '%d' for '%s', but found '%d'", expected, element, actual)
+        }
+    }
+}
+
+func TestCountingTrie_AddAllRollback(t *testing.T) {
+    trie := NewCountingTrie(26)
+    _ = trie.Add("apple")
+
+    if err := trie.AddAll(list.NewArrayListOf([]interface{}{ "apple", "banana", 42 })); err == nil {
+        t.Error("expected error when adding an int")
+    }
+
+    assertSize(t, trie, 1)
+    if actual := trie.Count("apple"); actual != 1 {
+        t.Errorf("expected count of '1', but found '%d'", actual)
+    }
+}
+
+func TestCountingTrie_RemoveMinMax(t *testing.T) {
+    trie := NewCountingTrie(26)
+    _ = trie.AddAll(list.NewArrayListOf([]interface{}{ "apple", "banana", "apple", "cherry", "cherry" }))
+
+    for _, expected := range []string{ "apple", "apple", "banana" } {
+        if actual := trie.RemoveMin(); actual != expected {
+            t.Errorf("expected '%s', but found '%v'", expected, actual)
+        }
+    }
+
+    assertSize(t, trie, 1)
+    if actual := trie.RemoveMax(); actual != "cherry" || trie.Count("cherry") != 1 {
+        t.Errorf("expected one occurrence of 'cherry' to be removed, but found '%v' with count '%d'", actual, trie.Count("cherry"))
+    }
+
+    trie.RemoveMax()
+    if !trie.IsEmpty() || trie.RemoveMax() != nil {
+        t.Error("expected the trie to be drained")
+    }
+}
+
+func TestCountingTrie_Remove(t *testing.T) {
+    trie := NewCountingTrie(26)
+    for i := 0; i < 3; i++ {
+        assertError(t, trie.Add("apple"), nil)
+    }
+    assertError(t, trie.Add("apricot"), nil)
+
+    for i := 0; i < 2; i++ {
+        if !trie.Remove("apple") {
+            t.Error("expected result to be true")
+        }
+    }
+
+    assertSize(t, trie, 2)
+    assertContains(t, trie, "apple", true)
+    if actual := trie.Count("apple"); actual != 1 {
+        t.Errorf("expected count of '1', but found '%d'", actual)
+    }
+
+    if !trie.Remove("apple") || trie.Remove("apple") {
+        t.Error("expected exactly one further removal of 'apple'")
+    }
+
+    assertSize(t, trie, 1)
+    assertContains(t, trie, "apple", false)
+    assertContentEquals(t, trie, "[apricot]")
+
+    _ = trie.Add("apple")
+    _ = trie.Add("apple")
+    if count := trie.RemoveAll(list.NewArrayListOf([]interface{}{ "apple" })); count != 2 {
+        t.Errorf("expected '2' occurrences removed, but found '%d'", count)
+    }
+    assertContentEquals(t, trie, "[apricot]")
+
+    clone := trie.Clone().(CountingTrie)
+    _ = trie.Add("apricot")
+    if actual := clone.Count("apricot"); actual != 1 {
+        t.Errorf("expected count of '1' in clone, but found '%d'", actual)
+    }
+}
+
+func TestCountingTrie_MarshalBinary(t *testing.T) {
+    source := NewCountingTrie(26)
+    for _, element := range []interface{}{ "abc", "abc", "abc", "bcd" } {
+        _ = source.Add(element)
+    }
+
+    data, err := source.MarshalBinary()
+    assertError(t, err, nil)
+
+    target := NewCountingTrie(26)
+    _ = target.Add("cab")
+
+    assertError(t, target.UnmarshalBinary(data), nil)
+    assertContentEquals(t, target, "[abc, bcd]")
+    for element, expected := range map[string]int{ "abc": 3, "bcd": 1, "cab": 0 } {
+        if actual := target.Count(element); actual != expected {
+            t.Errorf("expected count of '%d' for '%s', but found '%d'", expected, element, actual)
+        }
+    }
+
+    plain := NewTrie(26)
+    _ = plain.Add("abc")
+    data, _ = plain.MarshalBinary()
+
+    assertError(t, target.UnmarshalBinary(data), nil)
+    if actual := target.Count("abc"); actual != 1 {
+        t.Errorf("expected count of '1', but found '%d'", actual)
+    }
+}
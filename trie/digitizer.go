@@ -1,6 +1,13 @@
 package trie
 
-import "strings"
+import (
+    "fmt"
+    "strconv"
+    "strings"
+    "unicode/utf8"
+
+    "github.com/pkg/errors"
+)
 
 // Digitizer
 type Digitizer interface {
@@ -19,17 +26,70 @@ type Digitizer interface {
 
     // FormatDigit returns a string representation of the digit in the place specified for the given element.
     FormatDigit(element interface{}, place int) string
+
+    // Compare returns -1, 0, or 1 if the provided element a is respectively ordered before, equivalent to, or after the
+    // provided element b by their digits. Implementations without a more efficient approach delegate to CompareDigits.
+    Compare(a interface{}, b interface{}) int
+
+    // Accepts returns a non-nil error if the provided element cannot be digitized, such as an element of an unsupported
+    // type or one containing characters outside of the alphabet of the Digitizer.
+    Accepts(element interface{}) error
+
+    // Alphabet returns the characters accepted by the Digitizer in the order of their digits, excluding the end of key
+    // character (if any).
+    Alphabet() []rune
+
+    // IsValidDigit returns true if the provided digit is mapped to by a character of the alphabet of the Digitizer,
+    // otherwise false is returned. The end of key digit of a prefix free Digitizer is not a valid digit.
+    IsValidDigit(digit int) bool
+}
+
+// BatchDigitizer defines the behavior for a Digitizer that can compute every digit of an element in a single pass. The
+// DigitOf of some Digitizers is linear in the place of the digit (e.g. a RuneDigitizer decodes the runes before the
+// place), so a trie whose Digitizer implements BatchDigitizer computes the digits of the searched element once per
+// search rather than once per node descended.
+type BatchDigitizer interface {
+    Digitizer
+
+    // DigitsOf returns the digits of the provided element in order of their places, including the end of string digit
+    // (if any). The length of the returned slice is NumDigitsOf(element), and the digit at each place is equal to
+    // DigitOf(element, place).
+    DigitsOf(element interface{}) []int
+}
+
+// PrefixDigitizer defines the behavior for a Digitizer whose prefixes are not necessarily elements it accepts (e.g. a
+// FixedLengthDigitizer accepts prefixes shorter than its fixed length). A Trie validates the prefixes of its prefix
+// queries (e.g. Trie.Completions) with AcceptsPrefix if its Digitizer implements PrefixDigitizer, and with Accepts
+// otherwise.
+type PrefixDigitizer interface {
+    Digitizer
+
+    // AcceptsPrefix returns a non-nil error if the provided prefix cannot be digitized.
+    AcceptsPrefix(prefix interface{}) error
 }
 
 type stringDigitizer struct {
-    base int
+    base          int
+    caseSensitive bool
 }
 
-// NewStringDigitizer creates a new Digitizer for strings with the provided alphabet size.
+// NewStringDigitizer creates a new Digitizer for strings with the provided alphabet size. Upper and lower case letters
+// are mapped to the same digit.
 func NewStringDigitizer(alphabetSize int) Digitizer {
     return &stringDigitizer{ base: alphabetSize + 1 }
 }
 
+// NewCaseSensitiveStringDigitizer creates a new Digitizer for strings with the provided alphabet size, where upper and
+// lower case letters are mapped to distinct digits. The upper case variant of each letter immediately precedes the
+// lower case variant, so strings are ordered alphabetically with upper case first among otherwise equal strings
+// (e.g. "Foo" < "foo" < "Goo").
+func NewCaseSensitiveStringDigitizer(alphabetSize int) Digitizer {
+    return &stringDigitizer{
+        base:          2 * alphabetSize + 1,
+        caseSensitive: true,
+    }
+}
+
 // Base the base of the alphabet that includes the end of string character.
 func (d *stringDigitizer) Base() int {
     return d.base
@@ -49,9 +109,18 @@ func (d *stringDigitizer) NumDigitsOf(element interface{}) int {
 func (d *stringDigitizer) DigitOf(element interface{}, place int) int {
     if place >= len(element.(string)) {
         return 0
-    } else {
+    }
+
+    if !d.caseSensitive {
         return int(strings.ToLower(element.(string))[place] - 'a' + 1)
     }
+
+    c := element.(string)[place]
+    if c >= 'A' && c <= 'Z' {
+        return int(c - 'A') * 2 + 1
+    } else {
+        return int(c - 'a') * 2 + 2
+    }
 }
 
 // FormatDigit returns a string representation of the digit in the place specified for the given element where '#' is
@@ -59,7 +128,604 @@ func (d *stringDigitizer) DigitOf(element interface{}, place int) int {
 func (d *stringDigitizer) FormatDigit(element interface{}, place int) string {
     if place >= len(element.(string)) {
         return "#"
-    } else {
+    }
+
+    if !d.caseSensitive {
         return string(strings.ToLower(element.(string))[place])
     }
-}
\ No newline at end of file
+
+    return string(element.(string)[place])
+}
+
+// Compare returns -1, 0, or 1 if the provided element a is respectively ordered before, equivalent to, or after the
+// provided element b by their digits.
+func (d *stringDigitizer) Compare(a interface{}, b interface{}) int {
+    return CompareDigits(d, a, b)
+}
+
+// Accepts returns a non-nil error if the provided element is not a string, or contains characters outside of the
+// alphabet of the StringDigitizer.
+func (d *stringDigitizer) Accepts(element interface{}) error {
+    str, ok := element.(string)
+    if !ok {
+        return unsupportedType(element)
+    }
+
+    for place := 0; place < len(str); place++ {
+        if digit := d.DigitOf(str, place); digit < 1 || digit >= d.base {
+            return outsideAlphabet(str, place)
+        }
+    }
+
+    return nil
+}
+
+// Alphabet returns the letters of the StringDigitizer in order, where the upper case variant of each letter precedes
+// the lower case variant for a case sensitive StringDigitizer.
+func (d *stringDigitizer) Alphabet() []rune {
+    alphabet := make([]rune, 0, d.base - 1)
+    for digit := 1; digit < d.base; digit++ {
+        switch {
+        case !d.caseSensitive:
+            alphabet = append(alphabet, rune('a' + digit - 1))
+        case digit % 2 == 1:
+            alphabet = append(alphabet, rune('A' + (digit - 1) / 2))
+        default:
+            alphabet = append(alphabet, rune('a' + (digit - 2) / 2))
+        }
+    }
+
+    return alphabet
+}
+
+// IsValidDigit returns true if the provided digit is within 1 and the base of the StringDigitizer (exclusive).
+func (d *stringDigitizer) IsValidDigit(digit int) bool {
+    return digit >= 1 && digit < d.base
+}
+
+type byteDigitizer struct{}
+
+// NewByteDigitizer creates a new Digitizer for strings whose alphabet consists of all 256 byte values, so any string
+// (e.g. containing spaces, numerals, or punctuation) can be stored. Strings are ordered by the byte values of their
+// characters, and upper and lower case letters are distinct.
+func NewByteDigitizer() Digitizer {
+    return &byteDigitizer{}
+}
+
+// Base returns 257, which is the number of byte values plus the end of string character.
+func (d *byteDigitizer) Base() int {
+    return 257
+}
+
+// IsPrefixFree returns true since this is a prefix free digitizer.
+func (d *byteDigitizer) IsPrefixFree() bool {
+    return true
+}
+
+// NumDigitsOf returns the number of digits in the provided string including the end of string character.
+func (d *byteDigitizer) NumDigitsOf(element interface{}) int {
+    return len(element.(string)) + 1
+}
+
+// DigitOf returns the integer element mapped to by the digit in the given place, which is one greater than the value
+// of the byte in the given place.
+func (d *byteDigitizer) DigitOf(element interface{}, place int) int {
+    if place >= len(element.(string)) {
+        return 0
+    } else {
+        return int(element.(string)[place]) + 1
+    }
+}
+
+// FormatDigit returns a string representation of the digit in the place specified for the given element where '#' is
+// used for the end of string character.
+func (d *byteDigitizer) FormatDigit(element interface{}, place int) string {
+    if place >= len(element.(string)) {
+        return "#"
+    } else {
+        return string(element.(string)[place])
+    }
+}
+
+// Compare returns -1, 0, or 1 if the provided element a is respectively ordered before, equivalent to, or after the
+// provided element b by their digits.
+func (d *byteDigitizer) Compare(a interface{}, b interface{}) int {
+    return CompareDigits(d, a, b)
+}
+
+// Accepts returns a non-nil error if the provided element is not a string.
+func (d *byteDigitizer) Accepts(element interface{}) error {
+    if _, ok := element.(string); !ok {
+        return unsupportedType(element)
+    }
+
+    return nil
+}
+
+// Alphabet returns the 256 byte values as runes.
+func (d *byteDigitizer) Alphabet() []rune {
+    return byteAlphabet()
+}
+
+// IsValidDigit returns true if the provided digit is within 1 and 256 (inclusive).
+func (d *byteDigitizer) IsValidDigit(digit int) bool {
+    return digit >= 1 && digit < d.Base()
+}
+
+const (
+    intDigitizerWidth = 16
+    hexDigits         = "0123456789abcdef"
+)
+
+type intDigitizer struct{}
+
+// NewIntDigitizer creates a new Digitizer for signed integers (int, int8, int16, int32, and int64). Each integer is
+// represented as a fixed-width sequence of 16 hexadecimal digits of its 64-bit two's complement value with the sign bit
+// inverted, followed by an end of key character. As a result, integers are ordered numerically, with negative integers
+// ordered before positive integers. Integers of different types with the same value (e.g. int8(5) and int64(5)) have the
+// same digits, so a Trie considers them the same element: Contains, Remove, and the other queries of the Trie match an
+// element by its value regardless of its type, inserting an integer whose value already exists fails as a duplicate,
+// and the elements returned by the Trie retain the type they were inserted with.
+func NewIntDigitizer() Digitizer {
+    return &intDigitizer{}
+}
+
+// Base returns 17, which is the number of hexadecimal digits plus the end of key character.
+func (d *intDigitizer) Base() int {
+    return 17
+}
+
+// IsPrefixFree returns true since every integer has the same number of digits.
+func (d *intDigitizer) IsPrefixFree() bool {
+    return true
+}
+
+// NumDigitsOf returns the number of digits in the provided integer including the end of key character, which is
+// always 17.
+func (d *intDigitizer) NumDigitsOf(element interface{}) int {
+    return intDigitizerWidth + 1
+}
+
+// DigitOf returns the integer element mapped to by the digit in the given place, which is one greater than the value
+// of the hexadecimal digit in the given place, starting with the most significant digit.
+func (d *intDigitizer) DigitOf(element interface{}, place int) int {
+    if place >= intDigitizerWidth {
+        return 0
+    }
+
+    return d.hexDigitOf(element, place) + 1
+}
+
+// FormatDigit returns a string representation of the digit in the place specified for the given element where '#' is
+// used for the end of key character.
+func (d *intDigitizer) FormatDigit(element interface{}, place int) string {
+    if place >= intDigitizerWidth {
+        return "#"
+    }
+
+    return strconv.FormatInt(int64(d.hexDigitOf(element, place)), 16)
+}
+
+func (d *intDigitizer) hexDigitOf(element interface{}, place int) int {
+    var value int64
+    switch v := element.(type) {
+    case int:
+        value = int64(v)
+    case int8:
+        value = int64(v)
+    case int16:
+        value = int64(v)
+    case int32:
+        value = int64(v)
+    default:
+        value = element.(int64)
+    }
+
+    shift := uint(intDigitizerWidth - 1 - place) * 4
+
+    return int((uint64(value) ^ (1 << 63)) >> shift & 0xf)
+}
+
+// Compare returns -1, 0, or 1 if the provided element a is respectively ordered before, equivalent to, or after the
+// provided element b by their digits.
+func (d *intDigitizer) Compare(a interface{}, b interface{}) int {
+    return CompareDigits(d, a, b)
+}
+
+// Accepts returns a non-nil error if the provided element is not a signed integer. Every signed integer type is accepted,
+// and integers are digitized by their value (see NewIntDigitizer).
+func (d *intDigitizer) Accepts(element interface{}) error {
+    switch element.(type) {
+    case int, int8, int16, int32, int64:
+        return nil
+    default:
+        return unsupportedType(element)
+    }
+}
+
+// Alphabet returns the hexadecimal digits '0' through 'f'.
+func (d *intDigitizer) Alphabet() []rune {
+    return []rune(hexDigits)
+}
+
+// IsValidDigit returns true if the provided digit is within 1 and 16 (inclusive).
+func (d *intDigitizer) IsValidDigit(digit int) bool {
+    return digit >= 1 && digit < d.Base()
+}
+
+type byteSliceDigitizer struct{}
+
+// NewByteSliceDigitizer creates a new Digitizer for byte slices (e.g. hashes or other binary keys). Byte slices are
+// ordered lexicographically by the values of their bytes. Byte slices stored in a Trie should not be modified.
+func NewByteSliceDigitizer() Digitizer {
+    return &byteSliceDigitizer{}
+}
+
+// Base returns 257, which is the number of byte values plus the end of slice character.
+func (d *byteSliceDigitizer) Base() int {
+    return 257
+}
+
+// IsPrefixFree returns true since this is a prefix free digitizer.
+func (d *byteSliceDigitizer) IsPrefixFree() bool {
+    return true
+}
+
+// NumDigitsOf returns the number of digits in the provided byte slice including the end of slice character.
+func (d *byteSliceDigitizer) NumDigitsOf(element interface{}) int {
+    return len(element.([]byte)) + 1
+}
+
+// DigitOf returns the integer element mapped to by the digit in the given place, which is one greater than the value
+// of the byte in the given place.
+func (d *byteSliceDigitizer) DigitOf(element interface{}, place int) int {
+    if place >= len(element.([]byte)) {
+        return 0
+    } else {
+        return int(element.([]byte)[place]) + 1
+    }
+}
+
+// FormatDigit returns a two character hexadecimal representation of the byte in the place specified for the given
+// element where '#' is used for the end of slice character.
+func (d *byteSliceDigitizer) FormatDigit(element interface{}, place int) string {
+    if place >= len(element.([]byte)) {
+        return "#"
+    } else {
+        return fmt.Sprintf("%02x", element.([]byte)[place])
+    }
+}
+
+// Compare returns -1, 0, or 1 if the provided element a is respectively ordered before, equivalent to, or after the
+// provided element b by their digits.
+func (d *byteSliceDigitizer) Compare(a interface{}, b interface{}) int {
+    return CompareDigits(d, a, b)
+}
+
+// Accepts returns a non-nil error if the provided element is not a byte slice.
+func (d *byteSliceDigitizer) Accepts(element interface{}) error {
+    if _, ok := element.([]byte); !ok {
+        return unsupportedType(element)
+    }
+
+    return nil
+}
+
+// Alphabet returns the 256 byte values as runes.
+func (d *byteSliceDigitizer) Alphabet() []rune {
+    return byteAlphabet()
+}
+
+// IsValidDigit returns true if the provided digit is within 1 and 256 (inclusive).
+func (d *byteSliceDigitizer) IsValidDigit(digit int) bool {
+    return digit >= 1 && digit < d.Base()
+}
+
+const latin1Size = 256
+
+type runeDigitizer struct {
+    alphabet []rune
+    digits   map[rune]int
+}
+
+// NewRuneDigitizer creates a new Digitizer for strings whose alphabet consists of the Unicode code points U+0000 through
+// U+00FF (Latin-1), which includes the accented letters of most Western European languages. Unlike a StringDigitizer,
+// the digits of a string are its runes rather than its bytes, and strings are ordered by code point.
+func NewRuneDigitizer() Digitizer {
+    alphabet := make([]rune, latin1Size)
+    for i := range alphabet {
+        alphabet[i] = rune(i)
+    }
+
+    return newRuneDigitizer(alphabet)
+}
+
+// NewRuneDigitizerWithAlphabet creates a new Digitizer for strings whose alphabet consists of the runes in the provided
+// string, which allows strings of any script to be stored. Strings are ordered by the position of their runes within
+// the provided alphabet, and must not contain runes outside of it.
+func NewRuneDigitizerWithAlphabet(alphabet string) Digitizer {
+    return newRuneDigitizer([]rune(alphabet))
+}
+
+func newRuneDigitizer(alphabet []rune) *runeDigitizer {
+    digits := make(map[rune]int, len(alphabet))
+    for i, r := range alphabet {
+        if _, ok := digits[r]; !ok {
+            digits[r] = i + 1
+        }
+    }
+
+    return &runeDigitizer{ alphabet: alphabet, digits: digits }
+}
+
+// Base returns the size of the alphabet plus the end of string character.
+func (d *runeDigitizer) Base() int {
+    return len(d.alphabet) + 1
+}
+
+// IsPrefixFree returns true since this is a prefix free digitizer.
+func (d *runeDigitizer) IsPrefixFree() bool {
+    return true
+}
+
+// NumDigitsOf returns the number of runes in the provided string including the end of string character.
+func (d *runeDigitizer) NumDigitsOf(element interface{}) int {
+    return utf8.RuneCountInString(element.(string)) + 1
+}
+
+// DigitOf returns the integer element mapped to by the rune in the given place, which is one greater than the position
+// of the rune within the alphabet.
+func (d *runeDigitizer) DigitOf(element interface{}, place int) int {
+    r, ok := d.runeOf(element, place)
+    if !ok {
+        return 0
+    }
+
+    if digit, ok := d.digits[r]; ok {
+        return digit
+    }
+
+    return d.Base()
+}
+
+// DigitsOf returns the digits mapped to by the runes of the provided string followed by the end of string character,
+// which are decoded in a single pass rather than once per place as with DigitOf.
+func (d *runeDigitizer) DigitsOf(element interface{}) []int {
+    str    := element.(string)
+    digits := make([]int, 0, len(str) + 1)
+    for _, r := range str {
+        if digit, ok := d.digits[r]; ok {
+            digits = append(digits, digit)
+        } else {
+            digits = append(digits, d.Base())
+        }
+    }
+
+    return append(digits, 0)
+}
+
+// FormatDigit returns a string representation of the rune in the place specified for the given element where '#' is
+// used for the end of string character.
+func (d *runeDigitizer) FormatDigit(element interface{}, place int) string {
+    r, ok := d.runeOf(element, place)
+    if !ok {
+        return "#"
+    }
+
+    return string(r)
+}
+
+func (d *runeDigitizer) runeOf(element interface{}, place int) (rune, bool) {
+    i := 0
+    for _, r := range element.(string) {
+        if i == place {
+            return r, true
+        }
+        i++
+    }
+
+    return 0, false
+}
+
+// Compare returns -1, 0, or 1 if the provided element a is respectively ordered before, equivalent to, or after the
+// provided element b by their digits.
+func (d *runeDigitizer) Compare(a interface{}, b interface{}) int {
+    return CompareDigits(d, a, b)
+}
+
+// Accepts returns a non-nil error if the provided element is not a string, or contains runes outside of the alphabet of
+// the RuneDigitizer.
+func (d *runeDigitizer) Accepts(element interface{}) error {
+    str, ok := element.(string)
+    if !ok {
+        return unsupportedType(element)
+    }
+
+    place := 0
+    for _, r := range str {
+        if _, ok := d.digits[r]; !ok {
+            return outsideAlphabet(str, place)
+        }
+        place++
+    }
+
+    return nil
+}
+
+// Alphabet returns a copy of the alphabet of the RuneDigitizer.
+func (d *runeDigitizer) Alphabet() []rune {
+    return append([]rune(nil), d.alphabet...)
+}
+
+// IsValidDigit returns true if the provided digit is within 1 and the base of the RuneDigitizer (exclusive).
+func (d *runeDigitizer) IsValidDigit(digit int) bool {
+    return digit >= 1 && digit < d.Base()
+}
+
+const fixedLengthDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+type fixedLengthDigitizer struct {
+    length int
+    base   int
+}
+
+// NewFixedLengthDigitizer creates a new Digitizer for strings of the provided length (e.g. 4 character codes) whose
+// characters are digits in the provided base, where '0' through '9' are the digits 0 through 9, and 'a' through 'z'
+// are the digits 10 through 35 regardless of case (as with strconv.ParseInt). Since every key has the same number of
+// digits, no key can be a prefix of another, so no end of key character is appended and the Digitizer is not
+// considered prefix free. Strings shorter than the provided length are not accepted as keys, but may be used as
+// prefixes (e.g. for Trie.Completions).
+func NewFixedLengthDigitizer(length int, base int) Digitizer {
+    return &fixedLengthDigitizer{ length: length, base: base }
+}
+
+// Base returns the base of the digits of the keys.
+func (d *fixedLengthDigitizer) Base() int {
+    return d.base
+}
+
+// IsPrefixFree returns false since no end of key character is appended to the keys.
+func (d *fixedLengthDigitizer) IsPrefixFree() bool {
+    return false
+}
+
+// NumDigitsOf returns the number of digits in the provided string, which is the fixed length for every accepted key.
+// A prefix shorter than the fixed length has one digit for each of its characters.
+func (d *fixedLengthDigitizer) NumDigitsOf(element interface{}) int {
+    if n := len(element.(string)); n < d.length {
+        return n
+    }
+
+    return d.length
+}
+
+// DigitOf returns the value of the digit in the given place.
+func (d *fixedLengthDigitizer) DigitOf(element interface{}, place int) int {
+    c := element.(string)[place]
+    switch {
+    case c >= '0' && c <= '9':
+        return int(c - '0')
+    case c >= 'a' && c <= 'z':
+        return int(c - 'a') + 10
+    case c >= 'A' && c <= 'Z':
+        return int(c - 'A') + 10
+    default:
+        return d.base
+    }
+}
+
+// FormatDigit returns a string representation of the digit in the place specified for the given element, using the
+// lower case letters for the digits 10 through 35.
+func (d *fixedLengthDigitizer) FormatDigit(element interface{}, place int) string {
+    return strconv.FormatInt(int64(d.DigitOf(element, place)), 36)
+}
+
+// Compare returns -1, 0, or 1 if the provided element a is respectively ordered before, equivalent to, or after the
+// provided element b by their digits.
+func (d *fixedLengthDigitizer) Compare(a interface{}, b interface{}) int {
+    return CompareDigits(d, a, b)
+}
+
+// Accepts returns a non-nil error if the provided element is not a string of the fixed length, or contains characters
+// that are not digits in the base of the FixedLengthDigitizer.
+func (d *fixedLengthDigitizer) Accepts(element interface{}) error {
+    if err := d.AcceptsPrefix(element); err != nil {
+        return err
+    }
+
+    if str := element.(string); len(str) != d.length {
+        return errors.Errorf("element of length %v does not have the fixed length of the digitizer %v: %v", len(str), d.length, str)
+    }
+
+    return nil
+}
+
+// AcceptsPrefix returns a non-nil error if the provided prefix is not a string no longer than the fixed length, or
+// contains characters that are not digits in the base of the FixedLengthDigitizer.
+func (d *fixedLengthDigitizer) AcceptsPrefix(prefix interface{}) error {
+    str, ok := prefix.(string)
+    if !ok {
+        return unsupportedType(prefix)
+    }
+
+    if len(str) > d.length {
+        return errors.Errorf("element of length %v does not have the fixed length of the digitizer %v: %v", len(str), d.length, str)
+    }
+
+    for place := 0; place < len(str); place++ {
+        if digit := d.DigitOf(str, place); digit >= d.base {
+            return outsideAlphabet(str, place)
+        }
+    }
+
+    return nil
+}
+
+// Alphabet returns the characters of the digits in the base of the FixedLengthDigitizer, using the lower case letters
+// for the digits 10 through 35.
+func (d *fixedLengthDigitizer) Alphabet() []rune {
+    if d.base < len(fixedLengthDigits) {
+        return []rune(fixedLengthDigits[:d.base])
+    }
+
+    return []rune(fixedLengthDigits)
+}
+
+// IsValidDigit returns true if the provided digit is within 0 and the base of the FixedLengthDigitizer (exclusive).
+func (d *fixedLengthDigitizer) IsValidDigit(digit int) bool {
+    return digit >= 0 && digit < d.base
+}
+
+// CompareDigits returns -1, 0, or 1 if the provided element a is respectively ordered before, equivalent to, or after
+// the provided element b according to the provided Digitizer. The elements are compared digit by digit, and an element
+// whose digits are a prefix of the digits of the other element is ordered first.
+func CompareDigits(digitizer Digitizer, a interface{}, b interface{}) int {
+    numDigitsInA := digitizer.NumDigitsOf(a)
+    numDigitsInB := digitizer.NumDigitsOf(b)
+
+    for place := 0; place < numDigitsInA && place < numDigitsInB; place++ {
+        digitOfA, digitOfB := digitizer.DigitOf(a, place), digitizer.DigitOf(b, place)
+        if digitOfA < digitOfB {
+            return -1
+        } else if digitOfA > digitOfB {
+            return 1
+        }
+    }
+
+    switch {
+    case numDigitsInA < numDigitsInB:
+        return -1
+    case numDigitsInA > numDigitsInB:
+        return 1
+    default:
+        return 0
+    }
+}
+
+// batchDigitsOf returns the digits of the provided element if the provided Digitizer is a BatchDigitizer, otherwise nil
+// is returned so that the digits are computed per place with DigitOf.
+func batchDigitsOf(digitizer Digitizer, element interface{}) []int {
+    if batch, ok := digitizer.(BatchDigitizer); ok {
+        return batch.DigitsOf(element)
+    }
+
+    return nil
+}
+
+// byteAlphabet returns the 256 byte values as runes, in order.
+func byteAlphabet() []rune {
+    alphabet := make([]rune, 256)
+    for i := range alphabet {
+        alphabet[i] = rune(i)
+    }
+
+    return alphabet
+}
+
+func unsupportedType(element interface{}) error {
+    return errors.Errorf("element of type %T is not supported by the digitizer: %v", element, element)
+}
+
+func outsideAlphabet(element interface{}, place int) error {
+    return errors.Errorf("element contains a character outside of the digitizer alphabet at place %v: %v", place, element)
+}
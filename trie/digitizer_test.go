@@ -0,0 +1,480 @@
+package trie
+
+import (
+    "strings"
+    "testing"
+
+    "github.com/2speed/go-collection/list"
+)
+
+func TestStringDigitizer_Compare(t *testing.T) {
+    digitizer := NewStringDigitizer(26)
+
+    for _, c := range []struct {
+        a, b     string
+        expected int
+    }{
+        { a: "dab",  b: "dab",   expected: 0 },
+        { a: "Dab",  b: "dab",   expected: 0 },
+        { a: "da",   b: "dab",   expected: -1 },
+        { a: "dab",  b: "da",    expected: 1 },
+        { a: "dabb", b: "dac",   expected: -1 },
+        { a: "z",    b: "abcde", expected: 1 },
+        { a: "",     b: "a",     expected: -1 },
+    } {
+        if actual := digitizer.Compare(c.a, c.b); actual != c.expected {
+            t.Errorf("expected Compare('%s', '%s') to be %d, but found %d", c.a, c.b, c.expected, actual)
+        }
+    }
+
+    if actual := NewCaseSensitiveStringDigitizer(26).Compare("Foo", "foo"); actual != -1 {
+        t.Errorf("expected 'Foo' to be ordered before 'foo', but found %d", actual)
+    }
+}
+
+func TestStringDigitizer_CaseSensitive(t *testing.T) {
+    trie   := NewTrieWithDigitizer(NewCaseSensitiveStringDigitizer(26))
+    values := []interface{}{ "foo", "Foo", "goo", "FOO", "Goo" }
+    err    := trie.AddAll(list.NewArrayListOf(values))
+
+    assertError(t, err, nil)
+    assertSize(t, trie, 5)
+    assertContains(t, trie, "Foo", true)
+    assertContains(t, trie, "foo", true)
+    assertContains(t, trie, "fOO", false)
+    assertContentEquals(t, trie, "[FOO, Foo, foo, Goo, goo]")
+
+    trie.Remove("Foo")
+    assertContains(t, trie, "Foo", false)
+    assertContains(t, trie, "foo", true)
+
+    digitizer := NewCaseSensitiveStringDigitizer(26)
+    for _, element := range []string{ "Foo", "foo", "zZ" } {
+        for place := 0; place < digitizer.NumDigitsOf(element); place++ {
+            digit := digitizer.DigitOf(element, place)
+            if digit < 0 || digit >= digitizer.Base() {
+                t.Errorf("expected digit of '%s' at place %d within base %d, but found %d", element, place, digitizer.Base(), digit)
+            }
+
+            if place < len(element) && digitizer.FormatDigit(element, place) != element[place:place + 1] {
+                t.Errorf("expected formatted digit '%s', but found '%s'", element[place:place + 1], digitizer.FormatDigit(element, place))
+            }
+        }
+    }
+}
+
+func TestStringDigitizer_CaseInsensitive(t *testing.T) {
+    trie := NewTrie(26)
+
+    assertError(t, trie.Add("foo"), nil)
+    if err := trie.Add("Foo"); err == nil {
+        t.Error("expected 'Foo' to collide with 'foo'")
+    }
+    assertContains(t, trie, "FOO", true)
+}
+
+func TestByteDigitizer(t *testing.T) {
+    trie   := NewTrieWithDigitizer(NewByteDigitizer())
+    values := []interface{}{ "node10", "hello world", "node1", "Node", "node2", "hello, world!", "" }
+    err    := trie.AddAll(list.NewArrayListOf(values))
+
+    assertError(t, err, nil)
+    assertSize(t, trie, 7)
+    assertContains(t, trie, "hello world", true)
+    assertContains(t, trie, "node1", true)
+    assertContains(t, trie, "node", false)
+    assertContentEquals(t, trie, "[, Node, hello world, hello, world!, node1, node10, node2]")
+
+    l := list.NewArrayList()
+    trie.Completions("node1", l)
+    assertContentEquals(t, l, "[node1, node10]")
+
+    l.Clear()
+    trie.Completions("hello", l)
+    assertContentEquals(t, l, "[hello world, hello, world!]")
+}
+
+func TestIntDigitizer(t *testing.T) {
+    trie   := NewTrieWithDigitizer(NewIntDigitizer())
+    values := []interface{}{ 42, -7, 0, 1 << 40, 15, 16, -1 << 40, 255 }
+    err    := trie.AddAll(list.NewArrayListOf(values))
+
+    assertError(t, err, nil)
+    assertSize(t, trie, 8)
+    assertContentEquals(t, trie, "[-1099511627776, -7, 0, 15, 16, 42, 255, 1099511627776]")
+
+    if !trie.Contains(15) || trie.Contains(14) {
+        t.Error("expected to contain 15 but not 14")
+    }
+
+    for _, c := range []struct {
+        name     string
+        actual   interface{}
+        expected interface{}
+    }{
+        { name: "Min",              actual: trie.Min(),                 expected: -1 << 40 },
+        { name: "Max",              actual: trie.Max(),                 expected: 1 << 40 },
+        { name: "Predecessor",      actual: trie.Predecessor(16),       expected: 15 },
+        { name: "Predecessor,Zero", actual: trie.Predecessor(0),        expected: -7 },
+        { name: "Predecessor,Min",  actual: trie.Predecessor(-1 << 40), expected: nil },
+        { name: "Successor",        actual: trie.Successor(15),         expected: 16 },
+        { name: "Successor,Absent", actual: trie.Successor(43),         expected: 255 },
+        { name: "Successor,Max",    actual: trie.Successor(1 << 40),    expected: nil },
+    } {
+        if c.actual != c.expected {
+            t.Errorf("%s: expected '%v', but found '%v'", c.name, c.expected, c.actual)
+        }
+    }
+
+    t.Run("DigitString", func(t *testing.T) {
+        for name, trie := range map[string]Trie{
+            "Trie":      NewTrieWithDigitizer(NewIntDigitizer()),
+            "RadixTree": NewRadixTreeWithDigitizer(NewIntDigitizer()),
+        } {
+            _ = trie.AddAll(list.NewArrayListOf([]interface{}{ 15, -7, 0, int64(-1 << 63) }))
+
+            // The digits are the raw encoding of each integer (sign bit inverted), rather than its value in hexadecimal.
+            expected := "[0000000000000000, 7ffffffffffffff9, 8000000000000000, 800000000000000f]"
+            if actual := trie.DigitString(); actual != expected {
+                t.Errorf("%s: expected '%s', but found '%s'", name, expected, actual)
+            }
+            assertContentEquals(t, trie, "[-9223372036854775808, -7, 0, 15]")
+        }
+    })
+
+    t.Run("MixedTypes", func(t *testing.T) {
+        trie := NewTrieWithDigitizer(NewIntDigitizer())
+        assertError(t, trie.Add(int8(5)), nil)
+
+        if !trie.Contains(int64(5)) || !trie.Contains(5) || trie.Contains(int64(6)) {
+            t.Error("expected integers to be contained by their value regardless of their type")
+        }
+        if err := trie.Add(int64(5)); err == nil {
+            t.Error("expected error when adding an integer whose value already exists")
+        }
+        if actual := trie.Floor(int64(5)); actual != int8(5) {
+            t.Errorf("expected the element to retain its type 'int8', but found '%T'", actual)
+        }
+        if !trie.Remove(int32(5)) || trie.Contains(int8(5)) {
+            t.Error("expected an integer to be removed by its value regardless of its type")
+        }
+        assertSize(t, trie, 0)
+    })
+
+    digitizer := NewIntDigitizer()
+    if actual := digitizer.FormatDigit(255, 15) + digitizer.FormatDigit(255, 14); actual != "ff" {
+        t.Errorf("expected formatted digits 'ff', but found '%s'", actual)
+    }
+}
+
+func TestByteSliceDigitizer(t *testing.T) {
+    trie   := NewTrieWithDigitizer(NewByteSliceDigitizer())
+    values := []interface{}{
+        []byte{ 0xde, 0xad, 0xbe, 0xef },
+        []byte{ 0x00, 0x01 },
+        []byte{ 0xde, 0xad },
+        []byte{ 0xff },
+        []byte{ 0xde, 0xad, 0xc0, 0xde },
+    }
+    err := trie.AddAll(list.NewArrayListOf(values))
+
+    assertError(t, err, nil)
+    assertSize(t, trie, 5)
+    assertContentEquals(t, trie, "[[0 1], [222 173], [222 173 190 239], [222 173 192 222], [255]]")
+
+    for _, v := range values {
+        if !trie.Contains(v) {
+            t.Errorf("expected to contain value: %v", v)
+        }
+    }
+
+    if trie.Contains([]byte{ 0xde }) {
+        t.Error("expected not to contain value: [222]")
+    }
+
+    l := list.NewArrayList()
+    trie.Completions([]byte{ 0xde, 0xad }, l)
+    assertContentEquals(t, l, "[[222 173], [222 173 190 239], [222 173 192 222]]")
+
+    if count := trie.CountCompletions([]byte{ 0xde, 0xad, 0xbe }); count != 1 {
+        t.Errorf("expected 1 completion, but found %d", count)
+    }
+
+    if actual := trie.DigitString(); actual != "[0001, dead, deadbeef, deadc0de, ff]" {
+        t.Errorf("expected '[0001, dead, deadbeef, deadc0de, ff]', but found '%s'", actual)
+    }
+
+    if actual := NewByteSliceDigitizer().FormatDigit([]byte{ 0x0f }, 0); actual != "0f" {
+        t.Errorf("expected formatted digit '0f', but found '%s'", actual)
+    }
+}
+
+func TestRuneDigitizer(t *testing.T) {
+    t.Run("Latin1", func(t *testing.T) {
+        trie   := NewTrieWithDigitizer(NewRuneDigitizer())
+        values := []interface{}{ "naïve", "café", "cafe", "naive", "caf", "cafés" }
+        err    := trie.AddAll(list.NewArrayListOf(values))
+
+        assertError(t, err, nil)
+        assertSize(t, trie, 6)
+        assertContains(t, trie, "café", true)
+        assertContains(t, trie, "cafè", false)
+        assertContentEquals(t, trie, "[caf, cafe, café, cafés, naive, naïve]")
+
+        l := list.NewArrayList()
+        trie.Completions("café", l)
+        assertContentEquals(t, l, "[café, cafés]")
+
+        l.Clear()
+        trie.Completions("naï", l)
+        assertContentEquals(t, l, "[naïve]")
+    })
+
+    t.Run("Alphabet", func(t *testing.T) {
+        trie   := NewTrieWithDigitizer(NewRuneDigitizerWithAlphabet("αβγδεζηθικλμνξοπρστυφχψω"))
+        values := []interface{}{ "γαμμα", "αλφα", "βητα", "αλφαβητο" }
+        err    := trie.AddAll(list.NewArrayListOf(values))
+
+        assertError(t, err, nil)
+        assertContentEquals(t, trie, "[αλφα, αλφαβητο, βητα, γαμμα]")
+
+        l := list.NewArrayList()
+        trie.Completions("αλ", l)
+        assertContentEquals(t, l, "[αλφα, αλφαβητο]")
+    })
+
+    digitizer := NewRuneDigitizer()
+    if actual := digitizer.NumDigitsOf("naïve"); actual != 6 {
+        t.Errorf("expected 6 digits, but found %d", actual)
+    }
+
+    if actual := digitizer.FormatDigit("naïve", 2); actual != "ï" {
+        t.Errorf("expected formatted digit 'ï', but found '%s'", actual)
+    }
+}
+
+func TestRuneDigitizer_DigitsOf(t *testing.T) {
+    for _, digitizer := range []Digitizer{ NewRuneDigitizer(), NewRuneDigitizerWithAlphabet("αβγ") } {
+        batch, ok := digitizer.(BatchDigitizer)
+        if !ok {
+            t.Fatal("expected RuneDigitizer to be a BatchDigitizer")
+        }
+
+        for _, element := range []string{ "", "naïve", "αβγ", "αxβ" } {
+            digits := batch.DigitsOf(element)
+            if len(digits) != digitizer.NumDigitsOf(element) {
+                t.Errorf("expected %d digits of '%s', but found %v", digitizer.NumDigitsOf(element), element, digits)
+                continue
+            }
+
+            for place, digit := range digits {
+                if expected := digitizer.DigitOf(element, place); digit != expected {
+                    t.Errorf("expected digit %d at place %d of '%s', but found %d", expected, place, element, digit)
+                }
+            }
+        }
+    }
+}
+
+// perPlaceDigitizer hides the DigitsOf of the embedded Digitizer, so that the digits are computed once per place.
+type perPlaceDigitizer struct {
+    Digitizer
+}
+
+func BenchmarkRuneDigitizer_Contains(b *testing.B) {
+    words := make([]interface{}, 0, 5000)
+    for _, word := range randomWords(5000, 1) {
+        words = append(words, strings.Repeat("é", 32) + word.(string))
+    }
+
+    for name, digitizer := range map[string]Digitizer{
+        "DigitsOf": NewRuneDigitizer(),
+        "DigitOf":  perPlaceDigitizer{ NewRuneDigitizer() },
+    } {
+        trie := NewTrieWithDigitizer(digitizer)
+        _ = trie.AddAll(list.NewArrayListOf(words))
+
+        b.Run(name, func(b *testing.B) {
+            for i := 0; i < b.N; i++ {
+                trie.Contains(words[i % len(words)])
+            }
+        })
+    }
+}
+
+func TestFixedLengthDigitizer(t *testing.T) {
+    for name, trie := range map[string]Trie{
+        "Trie":      NewTrieWithDigitizer(NewFixedLengthDigitizer(4, 36)),
+        "RadixTree": NewRadixTreeWithDigitizer(NewFixedLengthDigitizer(4, 36)),
+    } {
+        t.Run(name, func(t *testing.T) {
+            values := []interface{}{ "ab12", "AB13", "zz00", "0000", "ab1z", "ab20", "ab10" }
+            err    := trie.AddAll(list.NewArrayListOf(values))
+
+            assertError(t, err, nil)
+            assertSize(t, trie, 7)
+            assertContentEquals(t, trie, "[0000, ab10, ab12, AB13, ab1z, ab20, zz00]")
+
+            if actual := trie.DigitString(); actual != "[0000, ab10, ab12, ab13, ab1z, ab20, zz00]" {
+                t.Errorf("expected '[0000, ab10, ab12, ab13, ab1z, ab20, zz00]', but found '%s'", actual)
+            }
+
+            for _, value := range []string{ "ab1", "ab123", "ab!2", "ab12" } {
+                if err := trie.Add(value); err == nil {
+                    t.Errorf("expected error when adding '%s'", value)
+                }
+            }
+
+            completions := list.NewArrayList()
+            trie.Completions("ab1", completions)
+            assertContentEquals(t, completions, "[ab10, ab12, AB13, ab1z]")
+            assertContains(t, trie, "ab1", false)
+            assertContains(t, trie, "ab10", true)
+
+            for _, c := range []struct {
+                name     string
+                actual   interface{}
+                expected interface{}
+            }{
+                { name: "Predecessor",        actual: trie.Predecessor("ab12"), expected: "ab10" },
+                { name: "Predecessor,Absent", actual: trie.Predecessor("ab11"), expected: "ab10" },
+                { name: "Successor",          actual: trie.Successor("ab1z"),   expected: "ab20" },
+                { name: "Successor,Absent",   actual: trie.Successor("ab21"),   expected: "zz00" },
+                { name: "Floor",              actual: trie.Floor("ab19"),       expected: "AB13" },
+                { name: "Ceiling",            actual: trie.Ceiling("0001"),     expected: "ab10" },
+            } {
+                if c.actual != c.expected {
+                    t.Errorf("%s: expected '%v', but found '%v'", c.name, c.expected, c.actual)
+                }
+            }
+
+            if !trie.Remove("ab12") || trie.Remove("ab12") {
+                t.Error("expected exactly one removal of 'ab12'")
+            }
+
+            if actual := trie.RemoveAllWithPrefix("ab1"); actual != 3 {
+                t.Errorf("expected '3' elements to be removed, but found '%d'", actual)
+            }
+            assertContentEquals(t, trie, "[0000, ab20, zz00]")
+        })
+    }
+
+    digitizer := NewFixedLengthDigitizer(3, 10)
+    if digitizer.IsPrefixFree() || digitizer.NumDigitsOf("123") != 3 || digitizer.Accepts("12a") == nil {
+        t.Error("expected a decimal digitizer for 3 digit keys that is not prefix free")
+    }
+}
+
+func TestDigitizer_Accepts(t *testing.T) {
+    t.Run("Type", func(t *testing.T) {
+        trie := NewTrie(26)
+
+        if err := trie.Add(42); err == nil {
+            t.Error("expected error when adding an int to a string trie")
+        }
+
+        if trie.Contains(42) || trie.Remove(42) {
+            t.Error("expected Contains and Remove to be false for an int")
+        }
+        assertSize(t, trie, 0)
+    })
+
+    t.Run("Alphabet", func(t *testing.T) {
+        trie := NewTrie(4)
+
+        for _, value := range []string{ "abe", "a b", "node1", "ab!" } {
+            if err := trie.Add(value); err == nil {
+                t.Errorf("expected error when adding '%s'", value)
+            }
+        }
+        assertError(t, trie.Add("abcd"), nil)
+        assertSize(t, trie, 1)
+    })
+
+    for name, c := range map[string]struct {
+        digitizer Digitizer
+        accepted  interface{}
+        rejected  interface{}
+    }{
+        "Byte":      { digitizer: NewByteDigitizer(),                  accepted: "a b!",       rejected: []byte("ab") },
+        "ByteSlice": { digitizer: NewByteSliceDigitizer(),             accepted: []byte("ab"), rejected: "ab" },
+        "Int":       { digitizer: NewIntDigitizer(),                   accepted: int32(7),     rejected: uint(7) },
+        "Rune":      { digitizer: NewRuneDigitizerWithAlphabet("abc"), accepted: "cab",        rejected: "cad" },
+    } {
+        if err := c.digitizer.Accepts(c.accepted); err != nil {
+            t.Errorf("%s: expected '%v' to be accepted, but found '%s'", name, c.accepted, err)
+        }
+
+        if err := c.digitizer.Accepts(c.rejected); err == nil {
+            t.Errorf("%s: expected '%v' to be rejected", name, c.rejected)
+        }
+    }
+}
+
+func TestDigitizer_Alphabet(t *testing.T) {
+    for name, c := range map[string]struct {
+        digitizer Digitizer
+        alphabet  string
+        valid     []int
+        invalid   []int
+    }{
+        "String":        { digitizer: NewStringDigitizer(26),                alphabet: "abcdefghijklmnopqrstuvwxyz", valid: []int{ 1, 26 }, invalid: []int{ 0, 27, -1 } },
+        "CaseSensitive": { digitizer: NewCaseSensitiveStringDigitizer(3),    alphabet: "AaBbCc",                     valid: []int{ 1, 6 },  invalid: []int{ 0, 7 } },
+        "Int":           { digitizer: NewIntDigitizer(),                     alphabet: "0123456789abcdef",           valid: []int{ 1, 16 }, invalid: []int{ 0, 17 } },
+        "Rune":          { digitizer: NewRuneDigitizerWithAlphabet("ñab"),   alphabet: "ñab",                        valid: []int{ 1, 3 },  invalid: []int{ 0, 4 } },
+        "FixedLength":   { digitizer: NewFixedLengthDigitizer(4, 12),        alphabet: "0123456789ab",               valid: []int{ 0, 11 }, invalid: []int{ 12, -1 } },
+    } {
+        if actual := string(c.digitizer.Alphabet()); actual != c.alphabet {
+            t.Errorf("%s: expected alphabet '%s', but found '%s'", name, c.alphabet, actual)
+        }
+
+        for _, digit := range c.valid {
+            if !c.digitizer.IsValidDigit(digit) {
+                t.Errorf("%s: expected digit '%d' to be valid", name, digit)
+            }
+        }
+        for _, digit := range c.invalid {
+            if c.digitizer.IsValidDigit(digit) {
+                t.Errorf("%s: expected digit '%d' to be invalid", name, digit)
+            }
+        }
+    }
+
+    if n := len(NewByteDigitizer().Alphabet()); n != 256 {
+        t.Errorf("expected an alphabet of '256' bytes, but found '%d'", n)
+    }
+
+    t.Run("Trie", func(t *testing.T) {
+        trie := NewTrie(26)
+
+        for _, value := range []string{ "samus", "Yoshi" } {
+            assertError(t, trie.Add(value), nil)
+        }
+        for _, value := range []string{ "mega man", "r.o.b.", "luffy{", "`" } {
+            if err := trie.Add(value); err == nil {
+                t.Errorf("expected error when adding '%s'", value)
+            }
+        }
+        assertContentEquals(t, trie, "[samus, Yoshi]")
+
+        lenient := NewTrieWithDigitizer(&lenientDigitizer{ NewStringDigitizer(26) })
+        assertError(t, lenient.Add("samus"), nil)
+        if err := lenient.Add("luffy{"); err == nil {
+            t.Error("expected error when adding a character outside of the alphabet accepted by the digitizer")
+        }
+        if err := lenient.BulkAdd([]interface{}{ "yoshi", "r.o.b." }); err == nil {
+            t.Error("expected error when bulk adding a character outside of the alphabet accepted by the digitizer")
+        }
+        assertContentEquals(t, lenient, "[samus]")
+    })
+}
+
+// lenientDigitizer is a Digitizer that accepts every element, so that the digits of an element are only validated by
+// the Trie it is added to.
+type lenientDigitizer struct {
+    Digitizer
+}
+
+func (d *lenientDigitizer) Accepts(element interface{}) error {
+    return nil
+}
@@ -2,6 +2,8 @@ package trie
 
 import (
     "fmt"
+    "sort"
+    "sync"
 
     "github.com/pkg/errors"
 )
@@ -11,9 +13,12 @@ type Node interface {
     SetParent(parent Node)
     Parent() Node
     AddChildWithIndexOf(index int, child Node) error
+    ReplaceChildWithIndexOf(index int, child Node) (Node, error)
     ChildWithIndexOf(index int) (Node, error)
     RemoveChildWithIndexOf(index int) bool
     HasChildren() bool
+    Children() []Node
+    NumChildren() int
     SetValue(element interface{})
     Value() interface{}
     IsRoot() bool
@@ -36,6 +41,43 @@ func newNode(capacity int) Node {
     return &node{ children: make([]Node, capacity) }
 }
 
+// nodePool holds the internal nodes removed from tries, so that workloads that repeatedly insert and remove elements
+// reuse them rather than allocating a node for every branch.
+var nodePool = sync.Pool{
+    New: func() interface{} { return &node{} },
+}
+
+// acquireNode returns an internal node from the node pool with the provided capacity. The children of a pooled node
+// are reused when they have sufficient capacity.
+func acquireNode(capacity int) Node {
+    n := nodePool.Get().(*node)
+    if cap(n.children) < capacity {
+        n.children = make([]Node, capacity)
+    } else {
+        n.children = n.children[:capacity]
+    }
+
+    return n
+}
+
+// releaseNode clears the parent of the provided node, which has been removed from its trie. An internal node is also
+// cleared of its children and element and returned to the node pool, so the provided node must have no children that
+// are still in the trie.
+func releaseNode(n Node) {
+    n.SetParent(nil)
+
+    if internal, ok := n.(*node); ok && !internal.isRoot {
+        children := internal.children[:cap(internal.children)]
+        for i := range children {
+            children[i] = nil
+        }
+
+        internal.numChildren = 0
+        internal.element     = nil
+        nodePool.Put(internal)
+    }
+}
+
 func newRootNode(capacity int) Node {
     return &node{
         children: make([]Node, capacity),
@@ -73,6 +115,24 @@ func (n *node) AddChildWithIndexOf(index int, child Node) error {
     return nil
 }
 
+// ReplaceChildWithIndexOf places the provided child at the provided index, and returns the child it replaced (if any).
+// Replacing an empty index is equivalent to AddChildWithIndexOf.
+func (n *node) ReplaceChildWithIndexOf(index int, child Node) (Node, error) {
+    if index < 0 || index >= len(n.children) {
+        return nil, errors.Errorf("index out of bounds [Node.capacity = %v, requested index = %v]", cap(n.children), index)
+    }
+
+    previous := n.children[index]
+    if previous == nil {
+        n.numChildren++
+    }
+
+    n.children[index] = child
+    child.SetParent(n)
+
+    return previous, nil
+}
+
 // ChildWithIndexOf
 func (n *node) ChildWithIndexOf(index int) (Node, error) {
     if err := n.checkBounds(index); err != nil {
@@ -103,6 +163,23 @@ func (n *node) HasChildren() bool {
     return n.numChildren > 0
 }
 
+// Children
+func (n *node) Children() []Node {
+    children := make([]Node, 0, n.numChildren)
+    for _, child := range n.children {
+        if child != nil {
+            children = append(children, child)
+        }
+    }
+
+    return children
+}
+
+// NumChildren
+func (n *node) NumChildren() int {
+    return n.numChildren
+}
+
 // SetValue
 func (n *node) SetValue(element interface{}) {
     n.element = element
@@ -129,13 +206,158 @@ func (n *node) String() string {
 }
 
 func (n *node) checkBounds(index int) error {
-    if index < 0 || index > len(n.children) {
+    if index < 0 || index >= len(n.children) {
         return errors.Errorf("index out of bounds [Node.capacity = %v, requested index = %v]", cap(n.children), index)
     }
 
     return nil
 }
 
+// mapNode is a Node that stores its children in a map rather than a slice with an entry for every digit, so that nodes
+// with few children in a trie with a large base (e.g. bytes) only allocate space for the children they have.
+type mapNode struct {
+    parent   Node
+    children map[int]Node
+    capacity int
+    element  interface{}
+    isRoot   bool
+}
+
+func newMapNode(capacity int) Node {
+    return &mapNode{
+        children: make(map[int]Node),
+        capacity: capacity,
+    }
+}
+
+func newRootMapNode(capacity int) Node {
+    return &mapNode{
+        children: make(map[int]Node),
+        capacity: capacity,
+        isRoot:   true,
+    }
+}
+
+// SetParent
+func (n *mapNode) SetParent(parent Node) {
+    n.parent = parent
+}
+
+// Parent
+func (n *mapNode) Parent() Node {
+    return n.parent
+}
+
+// AddChildWithIndexOf
+func (n *mapNode) AddChildWithIndexOf(index int, child Node) error {
+    if err := n.checkBounds(index); err != nil {
+        return err
+    }
+
+    if n.children[index] != nil {
+        return errors.Errorf("child exists at index %v", index)
+    }
+
+    n.children[index] = child
+    child.SetParent(n)
+
+    return nil
+}
+
+// ReplaceChildWithIndexOf places the provided child at the provided index, and returns the child it replaced (if any).
+// Replacing an empty index is equivalent to AddChildWithIndexOf.
+func (n *mapNode) ReplaceChildWithIndexOf(index int, child Node) (Node, error) {
+    if err := n.checkBounds(index); err != nil {
+        return nil, err
+    }
+
+    previous := n.children[index]
+
+    n.children[index] = child
+    child.SetParent(n)
+
+    return previous, nil
+}
+
+// ChildWithIndexOf
+func (n *mapNode) ChildWithIndexOf(index int) (Node, error) {
+    if err := n.checkBounds(index); err != nil {
+        return nil, err
+    }
+
+    return n.children[index], nil
+}
+
+// RemoveChildWithIndexOf
+func (n *mapNode) RemoveChildWithIndexOf(index int) bool {
+    if _, ok := n.children[index]; ok {
+        delete(n.children, index)
+
+        return true
+    }
+
+    return false
+}
+
+// HasChildren
+func (n *mapNode) HasChildren() bool {
+    return len(n.children) > 0
+}
+
+// Children
+func (n *mapNode) Children() []Node {
+    indexes := make([]int, 0, len(n.children))
+    for index := range n.children {
+        indexes = append(indexes, index)
+    }
+    sort.Ints(indexes)
+
+    children := make([]Node, 0, len(indexes))
+    for _, index := range indexes {
+        children = append(children, n.children[index])
+    }
+
+    return children
+}
+
+// NumChildren
+func (n *mapNode) NumChildren() int {
+    return len(n.children)
+}
+
+// SetValue
+func (n *mapNode) SetValue(element interface{}) {
+    n.element = element
+}
+
+// Value
+func (n *mapNode) Value() interface{} {
+    return n.element
+}
+
+// IsRoot
+func (n *mapNode) IsRoot() bool {
+    return n.isRoot
+}
+
+// IsLeaf
+func (n *mapNode) IsLeaf() bool {
+    return false
+}
+
+// String
+func (n *mapNode) String() string {
+    return fmt.Sprintf("%v", n.element)
+}
+
+func (n *mapNode) checkBounds(index int) error {
+    if index < 0 || index >= n.capacity {
+        return errors.Errorf("index out of bounds [Node.capacity = %v, requested index = %v]", n.capacity, index)
+    }
+
+    return nil
+}
+
 // LeafNode
 type LeafNode interface {
     Node
@@ -185,10 +407,7 @@ func (l *leafNode) IsLeaf() bool {
 
 // AddAfter
 func (l *leafNode) AddAfter(leafNode LeafNode) {
-    l.SetNext(leafNode.Next())
-    leafNode.SetNext(l)
-    l.SetPrevious(leafNode)
-    l.next.SetPrevious(l)
+    linkAfter(l, leafNode)
 }
 
 // SetNext
@@ -235,4 +454,13 @@ func (l *leafNode) IsTail() bool {
 
 func (l *leafNode) markDeleted() {
     l.previous = nil
+}
+
+// linkAfter links the provided leaf node into the iteration order after the provided predecessor. Implementations that
+// embed a LeafNode use this so that they, rather than the embedded LeafNode, are linked into the iteration order.
+func linkAfter(l LeafNode, predecessor LeafNode) {
+    l.SetNext(predecessor.Next())
+    predecessor.SetNext(l)
+    l.SetPrevious(predecessor)
+    l.Next().SetPrevious(l)
 }
\ No newline at end of file
@@ -0,0 +1,176 @@
+package trie
+
+import (
+    "fmt"
+    "runtime"
+    "testing"
+
+    "github.com/2speed/go-collection/list"
+)
+
+func TestNode_Children(t *testing.T) {
+    parent := newNode(26)
+    if parent.NumChildren() != 0 || len(parent.Children()) != 0 {
+        t.Error("expected no children for a new node")
+    }
+
+    first, second := newLeafNode(), newLeafNode()
+    first.SetValue("b")
+    second.SetValue("y")
+
+    assertError(t, parent.AddChildWithIndexOf(24, second), nil)
+    assertError(t, parent.AddChildWithIndexOf(1, first), nil)
+
+    if actual := parent.NumChildren(); actual != 2 {
+        t.Errorf("expected '2' children, but found '%d'", actual)
+    }
+
+    children := parent.Children()
+    if len(children) != 2 || children[0] != first || children[1] != second {
+        t.Errorf("expected children '[b y]' in index order, but found '%v'", children)
+    }
+
+    if _, err := parent.ChildWithIndexOf(26); err == nil {
+        t.Error("expected error when accessing the child at the capacity of a node")
+    }
+
+    parent.RemoveChildWithIndexOf(1)
+    if actual := parent.Children(); parent.NumChildren() != 1 || len(actual) != 1 || actual[0] != second {
+        t.Errorf("expected children '[y]', but found '%v'", actual)
+    }
+}
+
+func TestNode_ReplaceChild(t *testing.T) {
+    for name, parent := range map[string]Node{ "Node": newNode(26), "MapNode": newMapNode(26) } {
+        first, second := newLeafNode(), newLeafNode()
+        first.SetValue("a")
+        second.SetValue("b")
+
+        if previous, err := parent.ReplaceChildWithIndexOf(1, first); err != nil || previous != nil {
+            t.Errorf("%s: expected to add to an empty index, but found '%v' (%v)", name, previous, err)
+        }
+
+        if previous, err := parent.ReplaceChildWithIndexOf(1, second); err != nil || previous != first {
+            t.Errorf("%s: expected to replace 'a', but found '%v' (%v)", name, previous, err)
+        }
+
+        if actual, _ := parent.ChildWithIndexOf(1); actual != second || second.Parent() != parent {
+            t.Errorf("%s: expected child 'b' at index 1, but found '%v'", name, actual)
+        }
+
+        if parent.NumChildren() != 1 || len(parent.Children()) != 1 {
+            t.Errorf("%s: expected '1' child, but found '%d'", name, parent.NumChildren())
+        }
+
+        if _, err := parent.ReplaceChildWithIndexOf(26, first); err == nil {
+            t.Errorf("%s: expected error when replacing a child outside the capacity", name)
+        }
+    }
+}
+
+func TestMapNode_Children(t *testing.T) {
+    parent := newMapNode(257)
+    child  := newLeafNode()
+    child.SetValue("a")
+
+    assertError(t, parent.AddChildWithIndexOf(200, child), nil)
+    if err := parent.AddChildWithIndexOf(200, child); err == nil {
+        t.Error("expected error when adding a child at an occupied index")
+    }
+    if err := parent.AddChildWithIndexOf(257, child); err == nil {
+        t.Error("expected error when adding a child outside the capacity")
+    }
+
+    if actual, _ := parent.ChildWithIndexOf(200); actual != child || child.Parent() != parent {
+        t.Errorf("expected child 'a' at index 200, but found '%v'", actual)
+    }
+
+    if parent.NumChildren() != 1 || !parent.RemoveChildWithIndexOf(200) || parent.HasChildren() {
+        t.Error("expected the only child to be removed")
+    }
+}
+
+func TestSparseTrie_Allocations(t *testing.T) {
+    values := make([]interface{}, 0, 64)
+    for i := 0; i < 64; i++ {
+        values = append(values, fmt.Sprintf("node-%03d-%x", i, i * 7919))
+    }
+
+    allocated := func(trie Trie) uint64 {
+        var before, after runtime.MemStats
+        runtime.ReadMemStats(&before)
+        _ = trie.AddAll(list.NewArrayListOf(values))
+        runtime.ReadMemStats(&after)
+
+        return after.TotalAlloc - before.TotalAlloc
+    }
+
+    dense  := allocated(NewTrieWithDigitizer(NewByteDigitizer()))
+    sparse := allocated(NewSparseTrieWithDigitizer(NewByteDigitizer()))
+    if sparse * 4 > dense {
+        t.Errorf("expected a sparse trie to allocate less than a quarter of '%d' bytes, but found '%d'", dense, sparse)
+    }
+
+    for name, newTrie := range map[string]func(Digitizer) Trie{
+        "Trie":      NewSparseTrieWithDigitizer,
+        "RadixTree": NewSparseRadixTreeWithDigitizer,
+    } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(NewByteDigitizer())
+            err  := trie.AddAll(list.NewArrayListOf([]interface{}{ "node2", "node10", "Node", "node1" }))
+
+            assertError(t, err, nil)
+            assertContentEquals(t, trie, "[Node, node1, node10, node2]")
+            assertContentEquals(t, trie.Clone(), "[Node, node1, node10, node2]")
+
+            trie.Remove("node1")
+            assertContains(t, trie, "node10", true)
+            assertNodeValue(t, trie.Successor("Node"), "node10")
+        })
+    }
+}
+
+func TestNodePool(t *testing.T) {
+    parent, child := acquireNode(26), acquireNode(26)
+    leafNode      := newLeafNode()
+    parent.SetValue("parent")
+    assertError(t, parent.AddChildWithIndexOf(3, child), nil)
+    assertError(t, parent.AddChildWithIndexOf(7, leafNode), nil)
+
+    releaseNode(parent)
+    if parent.HasChildren() || parent.Parent() != nil || parent.Value() != nil {
+        t.Errorf("expected a released node to have no children, parent or element")
+    }
+    if children := parent.(*node).children[:26]; children[3] != nil || children[7] != nil {
+        t.Errorf("expected a released node to hold no references to its children, but found '%v'", children)
+    }
+
+    releaseNode(child)
+    if child.Parent() != nil {
+        t.Errorf("expected a released node to have no parent, but found '%v'", child.Parent())
+    }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie  := newTrie(26)
+            words := randomWords(200, 1)
+
+            for cycle := 0; cycle < 3; cycle++ {
+                _ = trie.BulkAdd(words)
+                assertSize(t, trie, len(words))
+                for _, word := range words {
+                    assertContains(t, trie, word.(string), true)
+                }
+
+                for _, word := range words {
+                    trie.Remove(word)
+                }
+                assertSize(t, trie, 0)
+            }
+
+            err := trie.AddAll(list.NewArrayListOf([]interface{}{ "samus", "sam", "luffy" }))
+            assertError(t, err, nil)
+            assertContentEquals(t, trie, "[luffy, sam, samus]")
+        })
+    }
+}
@@ -2,7 +2,8 @@ package trie
 
 import (
     "math"
-    "reflect"
+
+    "github.com/2speed/go-collection"
 )
 
 type radixTree struct {
@@ -16,7 +17,27 @@ func NewRadixTree(capacity int) Trie {
 
 // NewRadixTreeWithDigitizer
 func NewRadixTreeWithDigitizer(digitizer Digitizer) Trie {
-    return &radixTree{ trie: newTrieWithDigitizer(digitizer) }
+    rt := &radixTree{ trie: newTrieWithDigitizer(digitizer) }
+    rt.operations = rt
+
+    return rt
+}
+
+// NewSparseRadixTreeWithDigitizer creates a new RadixTree whose nodes store their children in a map rather than a slice
+// with an entry for every digit (see NewSparseTrieWithDigitizer).
+func NewSparseRadixTreeWithDigitizer(digitizer Digitizer) Trie {
+    rt := NewRadixTreeWithDigitizer(digitizer).(*radixTree)
+    rt.sparse = true
+
+    return rt
+}
+
+// Clone returns a new RadixTree with the same Digitizer containing the elements of the RadixTree.
+func (rt *radixTree) Clone() collection.Collection {
+    clone := NewRadixTreeWithDigitizer(rt.digitizer).(*radixTree)
+    rt.copyTo(clone.trie)
+
+    return clone
 }
 
 func (rt *radixTree) find(element interface{}, sctx *searchContext) searchResult {
@@ -26,10 +47,17 @@ func (rt *radixTree) find(element interface{}, sctx *searchContext) searchResult
         return Unmatched
     }
 
+    return rt.resume(element, sctx)
+}
+
+// resume continues the search for the provided element from the current node of the provided search context, which
+// must be on the path of the element.
+func (rt *radixTree) resume(element interface{}, sctx *searchContext) searchResult {
     numDigitsInElement := rt.digitizer.NumDigitsOf(element)
+    digits             := batchDigitsOf(rt.digitizer, element)
 
     for sctx.branchPosition < numDigitsInElement && !sctx.atLeaf() {
-        if sctx.descendTo(element) == childNotFound {
+        if sctx.descendToDigitOf(element, digits) == childNotFound {
             return Unmatched
         }
     }
@@ -48,7 +76,7 @@ func (rt *radixTree) find(element interface{}, sctx *searchContext) searchResult
 
 func (rt *radixTree) addNode(node Node, searchContext *searchContext) {
     if searchContext.pointer == nil {
-        rt.root = newRootNode(rt.capacity)
+        rt.root = rt.createRootNode()
         searchContext.pointer = rt.root
     }
 
@@ -60,7 +88,7 @@ func (rt *radixTree) addNode(node Node, searchContext *searchContext) {
         searchContext.ascend()
 
         for searchContext.childIndexOf(element) == searchContext.childIndexOf(leafNode.Value()) {
-            searchContext.extendPath(element, newNode(rt.capacity))
+            searchContext.extendPath(element, rt.createNode())
         }
 
         searchContext.extendPath(leafNode.Value(), leafNode)
@@ -71,7 +99,40 @@ func (rt *radixTree) addNode(node Node, searchContext *searchContext) {
 }
 
 func (rt *radixTree) remove(node Node) {
-    // TODO: implement
+    if leafNode, ok := node.(LeafNode); ok {
+        leafNode.Remove()
+    }
+
+    rt.detach(node, node.Value(), rt.digitizer.NumDigitsOf(node.Value()))
+
+    rt.size--
+}
+
+// detach removes the provided node from its parent. Since a leaf is placed as close to the root as its unique prefix
+// allows, any ancestor left with no children is removed, and any ancestor left with a single leaf child is replaced by
+// that leaf. Children are located by identity, so the provided element and level are not needed. The removed nodes
+// without children in the trie are released to the node pool.
+func (rt *radixTree) detach(node Node, element interface{}, level int) {
+    parent := node.Parent()
+    parent.RemoveChildWithIndexOf(rt.childIndexOf(parent, node))
+    if !node.HasChildren() {
+        releaseNode(node)
+    }
+
+    for node = parent; !node.IsRoot(); node = parent {
+        parent = node.Parent()
+        index := rt.childIndexOf(parent, node)
+
+        children := node.Children()
+        if len(children) == 0 {
+            parent.RemoveChildWithIndexOf(index)
+        } else if len(children) == 1 && children[0].IsLeaf() {
+            _, _ = parent.ReplaceChildWithIndexOf(index, children[0])
+        } else {
+            break
+        }
+        releaseNode(node)
+    }
 }
 
 func (rt *radixTree) checkMatchFromLeaf(element interface{}, sctx *searchContext) searchResult {
@@ -108,12 +169,11 @@ func (rt *radixTree) checkMatchFromLeaf(element interface{}, sctx *searchContext
 }
 
 func (rt *radixTree) childIndexOf(parent Node, child Node) int {
-    var index int
-    c, _ := parent.ChildWithIndexOf(index)
-    for !reflect.DeepEqual(c, child) && index < rt.capacity {
-        c, _ = parent.ChildWithIndexOf(index)
-        index++
+    for index := 0; index < rt.capacity; index++ {
+        if c, _ := parent.ChildWithIndexOf(index); c == child {
+            return index
+        }
     }
 
-    return index
+    return childNotFound
 }
@@ -1 +1,155 @@
 package trie
+
+import (
+    "testing"
+
+    "github.com/2speed/go-collection/list"
+)
+
+func TestRadixTree_Add(t *testing.T) {
+    tree   := NewRadixTree(4)
+    values := []interface{}{ "bac", "dab", "dabb", "dac", "daca", "dabba", "ab" }
+    err    := tree.AddAll(list.NewArrayListOf(values))
+
+    assertError(t, err, nil)
+    assertSize(t, tree, 7)
+    assertContentEquals(t, tree, "[ab, bac, dab, dabb, dabba, dac, daca]")
+    assertContains(t, tree, "dabb", true)
+    assertContains(t, tree, "da", false)
+    assertContains(t, tree, "dabbb", false)
+    assertNodeValue(t, tree.Predecessor("dabba"), "dabb")
+    assertNodeValue(t, tree.Successor("dabba"), "dac")
+
+    assertLeafAtPath(t, tree, "a")
+    assertLeafAtPath(t, tree, "b")
+}
+
+func TestRadixTree_Remove(t *testing.T) {
+    tree   := NewRadixTree(26)
+    values := []interface{}{ "jumped", "over", "the", "lazy", "dog" }
+    err    := tree.AddAll(list.NewArrayListOf(values))
+
+    assertError(t, err, nil)
+    assertSize(t, tree, 5)
+    assertContentEquals(t, tree, "[dog, jumped, lazy, over, the]")
+
+    tree.Remove("lazy")
+    tree.Remove("the")
+    tree.Remove("fox")
+    assertSize(t, tree, 3)
+
+    assertContains(t, tree, "lazy", false)
+    assertContains(t, tree, "the", false)
+    assertContentEquals(t, tree, "[dog, jumped, over]")
+
+    tree.Clear()
+    assertSize(t, tree, 0)
+    assertContentEquals(t, tree, "[]")
+
+    err = tree.Add("fox")
+    assertError(t, err, nil)
+    assertContains(t, tree, "fox", true)
+    assertContentEquals(t, tree, "[fox]")
+}
+
+func TestRadixTree_RemoveCompression(t *testing.T) {
+    t.Run("Sibling", func(t *testing.T) {
+        tree := NewRadixTree(4)
+        _ = tree.AddAll(list.NewArrayListOf([]interface{}{ "dada", "dadc", "ab" }))
+
+        assertLeafAtPath(t, tree, "a")
+        assertInternalAtPath(t, tree, "dad")
+
+        if !tree.Remove("dadc") {
+            t.Error("expected result to be true")
+        }
+
+        assertSize(t, tree, 2)
+        assertLeafAtPath(t, tree, "d")
+        assertContains(t, tree, "dada", true)
+        assertContentEquals(t, tree, "[ab, dada]")
+    })
+
+    t.Run("PrefixBranch", func(t *testing.T) {
+        tree := NewRadixTree(4)
+        _ = tree.AddAll(list.NewArrayListOf([]interface{}{ "da", "dab", "dac" }))
+
+        tree.Remove("da")
+        assertInternalAtPath(t, tree, "da")
+
+        tree.Remove("dac")
+        assertLeafAtPath(t, tree, "d")
+        assertContentEquals(t, tree, "[dab]")
+        assertNodeValue(t, tree.Min(), "dab")
+    })
+
+    t.Run("Last", func(t *testing.T) {
+        tree := NewRadixTree(4)
+        _ = tree.Add("abcd")
+
+        if !tree.Remove("abcd") {
+            t.Error("expected result to be true")
+        }
+
+        assertSize(t, tree, 0)
+        assertContains(t, tree, "abcd", false)
+
+        if tree.Min() != nil {
+            t.Errorf("expected nil Min, but found '%v'", tree.Min())
+        }
+
+        if tree.(*radixTree).root.HasChildren() {
+            t.Error("expected root to have no children")
+        }
+    })
+}
+
+func TestRadixTree_Completions(t *testing.T) {
+    tree   := NewRadixTree(4)
+    values := []interface{}{ "acb", "dabc", "daca", "da", "ab" }
+    err    := tree.AddAll(list.NewArrayListOf(values))
+
+    assertError(t, err, nil)
+
+    l := list.NewArrayList()
+    tree.Completions("a", l)
+    assertContentEquals(t, l, "[ab, acb]")
+
+    l.Clear()
+    tree.Completions("da", l)
+    assertContentEquals(t, l, "[da, dabc, daca]")
+}
+
+func assertLeafAtPath(t *testing.T, tree Trie, path string) {
+    t.Helper()
+
+    if node := nodeAtPath(t, tree, path); node != nil && !node.IsLeaf() {
+        t.Errorf("expected leaf node at path '%s'", path)
+    }
+}
+
+func assertInternalAtPath(t *testing.T, tree Trie, path string) {
+    t.Helper()
+
+    if node := nodeAtPath(t, tree, path); node != nil && node.IsLeaf() {
+        t.Errorf("expected internal node at path '%s'", path)
+    }
+}
+
+func nodeAtPath(t *testing.T, tree Trie, path string) Node {
+    t.Helper()
+
+    rt   := tree.(*radixTree)
+    node := rt.root
+    for place := 0; place < len(path); place++ {
+        child, err := node.ChildWithIndexOf(rt.digitizer.DigitOf(path, place))
+        if err != nil || child == nil {
+            t.Errorf("expected node at path '%s'", path[:place + 1])
+
+            return nil
+        }
+        node = child
+    }
+
+    return node
+}
@@ -1,7 +1,9 @@
 package trie
 
 import (
+    "fmt"
     "reflect"
+    "strings"
     "sync"
 
     "github.com/2speed/go-collection"
@@ -20,6 +22,11 @@ const (
 
 const childNotFound = -1
 
+const (
+    anyDigit    = -1
+    anySequence = -2
+)
+
 var searchContextPool = sync.Pool{
     New: func() interface{} { return &searchContext{} },
 }
@@ -51,6 +58,15 @@ func (s *searchContext) atRoot() bool {
     return s.pointer.Parent() == nil
 }
 
+func (s *searchContext) moveToMinDescendant() {
+    for !s.atLeaf() {
+        index := 0
+        for s.descendToIndex(index) == childNotFound {
+            index++
+        }
+    }
+}
+
 func (s *searchContext) moveToMaxDescendant() {
     for !s.atLeaf() {
         index := s.digitizer.Base() - 1
@@ -78,6 +94,16 @@ func (s *searchContext) descendTo(element interface{}) int {
     return s.descendToIndex(index)
 }
 
+// descendToDigitOf descends to the child of the current node for the digit of the provided element at the current branch
+// position, where the provided digits (if non-nil) are the precomputed digits of the element (see BatchDigitizer).
+func (s *searchContext) descendToDigitOf(element interface{}, digits []int) int {
+    if digits == nil {
+        return s.descendTo(element)
+    }
+
+    return s.descendToIndex(digits[s.branchPosition])
+}
+
 func (s *searchContext) descendToIndex(index int) int {
     child, err := s.pointer.ChildWithIndexOf(index)
     if err != nil || child == nil {
@@ -90,6 +116,12 @@ func (s *searchContext) descendToIndex(index int) int {
     return index
 }
 
+// descendToChild moves to the provided child of the current node.
+func (s *searchContext) descendToChild(child Node) {
+    s.branchPosition++
+    s.pointer = child
+}
+
 func (s *searchContext) ascend() int {
     s.branchPosition = s.parentBranchPosition()
     s.pointer = s.pointer.Parent()
@@ -97,19 +129,23 @@ func (s *searchContext) ascend() int {
     return s.branchPosition
 }
 
+// extendPath places the provided node as the child of the current node for the digit of the provided element at the
+// current branch position, replacing any existing child, and descends to it.
 func (s *searchContext) extendPath(element interface{}, node Node) int {
     index := s.digitizer.DigitOf(element, s.branchPosition)
-    node.AddChildWithIndexOf(index, node)
+    _, _ = s.pointer.ReplaceChildWithIndexOf(index, node)
 
     return s.descendToIndex(index)
 }
 
 func (s *searchContext) processedEndOfString(element interface{}) bool {
+    if !s.digitizer.IsPrefixFree() || s.pointer.IsRoot() {
+        return false
+    }
+
     childNode, _ := s.pointer.Parent().ChildWithIndexOf(0)
 
-    return s.digitizer.IsPrefixFree() &&
-        !s.pointer.IsRoot() &&
-        reflect.DeepEqual(childNode, s.pointer)
+    return reflect.DeepEqual(childNode, s.pointer)
 }
 
 func (s *searchContext) retraceToLastLeftFork(element interface{}) {
@@ -131,15 +167,240 @@ func (s *searchContext) retraceToLastLeftFork(element interface{}) {
     }
 }
 
+func (s *searchContext) numElementsInSubtree() int {
+    if s.atLeaf() {
+        return 1
+    }
+
+    count := 0
+    for _, child := range s.pointer.Children() {
+        s.descendToChild(child)
+        count += s.numElementsInSubtree()
+        s.ascend()
+    }
+
+    return count
+}
+
+// leafNodesInSubtree returns the first and last leaf nodes in iteration order of the subtree of the current node. The
+// search context is left at the current node.
+func (s *searchContext) leafNodesInSubtree() (LeafNode, LeafNode) {
+    subtree := s.pointer
+    level   := s.branchPosition
+
+    s.moveToMinDescendant()
+    first := s.pointer.(LeafNode)
+
+    s.pointer        = subtree
+    s.branchPosition = level
+    s.moveToMaxDescendant()
+    last := s.pointer.(LeafNode)
+
+    s.pointer        = subtree
+    s.branchPosition = level
+
+    return first, last
+}
+
+// depthsInSubtree returns the maximum and the sum of the depths of the leaves in the subtree of the current node, where
+// the depth of a leaf is its branch position.
+func (s *searchContext) depthsInSubtree() (int, int) {
+    if s.atLeaf() {
+        return s.branchPosition, s.branchPosition
+    }
+
+    max, sum := 0, 0
+    for _, child := range s.pointer.Children() {
+        s.descendToChild(child)
+        childMax, childSum := s.depthsInSubtree()
+        if childMax > max {
+            max = childMax
+        }
+        sum += childSum
+        s.ascend()
+    }
+
+    return max, sum
+}
+
 func (s *searchContext) elementsInSubtree(collection collection.Collection) {
     if s.atLeaf() {
         collection.Add(s.pointer.Value())
     } else {
-        for i := 0; i < s.digitizer.Base(); i++ {
-            if s.descendToIndex(i) != childNotFound {
-                s.elementsInSubtree(collection)
-                s.ascend()
+        for _, child := range s.pointer.Children() {
+            s.descendToChild(child)
+            s.elementsInSubtree(collection)
+            s.ascend()
+        }
+    }
+}
+
+// formatSubtree writes the subtree of the current node to the provided builder with one line per child node, where each
+// line is indented by the provided indent and labelled with the formatted digit of its edge. Lines of leaf nodes are
+// followed by the element of the leaf.
+func (s *searchContext) formatSubtree(builder *strings.Builder, indent string) {
+    children := s.pointer.Children()
+    for n, child := range children {
+        connector, childIndent := "+-- ", "|   "
+        if n == len(children) - 1 {
+            connector, childIndent = "`-- ", "    "
+        }
+
+        s.descendToChild(child)
+        builder.WriteString("\n" + indent + connector + s.digitizer.FormatDigit(s.minElementInSubtree(), s.branchPosition - 1))
+        if s.atLeaf() {
+            builder.WriteString(fmt.Sprintf(" (%v)", s.pointer.Value()))
+        } else {
+            s.formatSubtree(builder, indent + childIndent)
+        }
+        s.ascend()
+    }
+}
+
+// minElementInSubtree returns the first element in iteration order of the subtree of the current node. The search
+// context is left at the current node.
+func (s *searchContext) minElementInSubtree() interface{} {
+    subtree := s.pointer
+    level   := s.branchPosition
+
+    s.moveToMinDescendant()
+    element := s.pointer.Value()
+
+    s.pointer        = subtree
+    s.branchPosition = level
+
+    return element
+}
+
+// approximateMatches appends the elements in the subtree of the current node whose edit distance from the provided
+// query digits is at most the provided maximum distance. The provided row holds the edit distances between the path to
+// the current node and each prefix of the query digits.
+func (s *searchContext) approximateMatches(query []int, row []int, maxDistance int, collection collection.Collection) {
+    for i := 0; i < s.digitizer.Base(); i++ {
+        if s.descendToIndex(i) == childNotFound {
+            continue
+        }
+
+        if s.atLeaf() {
+            element   := s.pointer.Value()
+            numDigits := s.digitizer.NumDigitsOf(element)
+            if s.digitizer.IsPrefixFree() {
+                numDigits--
+            }
+
+            leafRow := row
+            for place := s.parentBranchPosition(); place < numDigits && minOf(leafRow) <= maxDistance; place++ {
+                leafRow = nextEditDistanceRow(query, leafRow, s.digitizer.DigitOf(element, place))
             }
+
+            if leafRow[len(query)] <= maxDistance {
+                collection.Add(element)
+            }
+        } else if childRow := nextEditDistanceRow(query, row, i); minOf(childRow) <= maxDistance {
+            s.approximateMatches(query, childRow, maxDistance, collection)
         }
+
+        s.ascend()
     }
-}
\ No newline at end of file
+}
+
+// nextEditDistanceRow returns the row of the edit distance table that follows the provided row after appending the
+// provided digit.
+func nextEditDistanceRow(query []int, row []int, digit int) []int {
+    next := make([]int, len(row))
+    next[0] = row[0] + 1
+
+    for j := 1; j < len(row); j++ {
+        substitution := row[j - 1]
+        if query[j - 1] != digit {
+            substitution++
+        }
+
+        next[j] = minOf([]int{ row[j] + 1, next[j - 1] + 1, substitution })
+    }
+
+    return next
+}
+
+func minOf(values []int) int {
+    min := values[0]
+    for _, v := range values[1:] {
+        if v < min {
+            min = v
+        }
+    }
+
+    return min
+}
+
+// wildcardMatches appends the elements in the subtree of the current node that match the provided pattern tokens. The
+// provided states indicate which positions of the pattern are reachable along the path to the current node.
+func (s *searchContext) wildcardMatches(tokens []int, states []bool, collection collection.Collection) {
+    for i := 0; i < s.digitizer.Base(); i++ {
+        if s.descendToIndex(i) == childNotFound {
+            continue
+        }
+
+        if s.atLeaf() {
+            element   := s.pointer.Value()
+            numDigits := s.digitizer.NumDigitsOf(element)
+            if s.digitizer.IsPrefixFree() {
+                numDigits--
+            }
+
+            leafStates := states
+            for place := s.parentBranchPosition(); place < numDigits && anyOf(leafStates); place++ {
+                leafStates = nextWildcardStates(tokens, leafStates, s.digitizer.DigitOf(element, place))
+            }
+
+            if leafStates[len(tokens)] {
+                collection.Add(element)
+            }
+        } else if childStates := nextWildcardStates(tokens, states, i); anyOf(childStates) {
+            s.wildcardMatches(tokens, childStates, collection)
+        }
+
+        s.ascend()
+    }
+}
+
+// nextWildcardStates returns the pattern positions reachable from the provided states after consuming the provided
+// digit.
+func nextWildcardStates(tokens []int, states []bool, digit int) []bool {
+    next := make([]bool, len(states))
+    for j, reachable := range states[:len(tokens)] {
+        if !reachable {
+            continue
+        }
+
+        switch tokens[j] {
+        case anySequence:
+            next[j] = true
+        case anyDigit, digit:
+            next[j + 1] = true
+        }
+    }
+
+    return closureOf(tokens, next)
+}
+
+// closureOf marks the position following each reachable "*" token as reachable, since "*" may match no digits.
+func closureOf(tokens []int, states []bool) []bool {
+    for j := range tokens {
+        if states[j] && tokens[j] == anySequence {
+            states[j + 1] = true
+        }
+    }
+
+    return states
+}
+
+func anyOf(states []bool) bool {
+    for _, reachable := range states {
+        if reachable {
+            return true
+        }
+    }
+
+    return false
+}
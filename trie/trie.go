@@ -1,7 +1,11 @@
 package trie
 
 import (
+    "bytes"
+    "encoding"
+    "encoding/gob"
     "fmt"
+    "sort"
     "strings"
 
     "github.com/2speed/go-collection"
@@ -11,25 +15,208 @@ import (
 // Trie
 type Trie interface {
     collection.Ordered
+    encoding.BinaryMarshaler
+    encoding.BinaryUnmarshaler
+    gob.GobEncoder
+    gob.GobDecoder
 
     // Completions finds all elements in the Trie that match the provided prefix, and appends the matching elements
     // (if any) to the provided collection.
     Completions(prefix interface{}, collection collection.Collection)
 
+    // CompletionsLimit finds (at most) the first limit elements in iteration order in the Trie that match the provided
+    // prefix, and appends the matching elements (if any) to the provided collection. Unlike Completions, the traversal
+    // stops once limit elements are found, so the remainder of the subtree containing the matching elements is not
+    // visited. A limit less than or equal to 0 finds no elements.
+    CompletionsLimit(prefix interface{}, limit int, collection collection.Collection)
+
+    // CountCompletions returns the number of elements in the Trie that match the provided prefix. The leaves of the
+    // subtree containing the matching elements are counted without collecting the elements. If no elements match the
+    // prefix, the return value will be 0.
+    CountCompletions(prefix interface{}) int
+
+    // BulkAdd inserts all of the provided elements into the Trie. The elements are sorted by their digits before they
+    // are inserted, so that the search for each element resumes from the longest prefix it shares with the element
+    // inserted before it rather than from the root. As with AddAll, the returned error will be non-nil if any element is
+    // not accepted by the Digitizer of the Trie or violates the prefix-free requirement, in which case the Trie is left
+    // unchanged.
+    BulkAdd(elements []interface{}) error
+
+    // AddWithPosition inserts the provided element into the Trie as with Add, and returns the position of the element
+    // in the iteration order once it has been inserted. Since the leaves of the Trie do not record their positions, the
+    // position is counted by traversing the elements before it, which is O(n). If the returned error is non-nil, the
+    // returned position will be collection.ElementNotFound.
+    AddWithPosition(element interface{}) (int, error)
+
+    // Merge inserts all elements of the provided Trie into the Trie, skipping those that already exist in the Trie. The
+    // returned error will be non-nil if the Digitizers of the Tries have different bases, or if any element cannot be
+    // inserted (e.g. it violates the prefix-free requirement, or a bounded Trie has reached capacity), in which case
+    // the elements inserted before the failure are removed so that the Trie is left unchanged.
+    Merge(other Trie) error
+
+    // ContainsAllOf returns a map from each of the provided elements to true if it exists in the Trie, otherwise false.
+    // Elements that are not hashable (see collection.IsHashable), such as byte slices, cannot be keys of the map, and
+    // are omitted.
+    ContainsAllOf(elements []interface{}) map[interface{}]bool
+
+    // HasPrefix returns true if at least one element in the Trie matches the provided prefix, otherwise false is
+    // returned. Unlike Contains, the prefix itself does not need to be an element of the Trie.
+    HasPrefix(prefix interface{}) bool
+
+    // Lookup returns whether the provided element exists in the Trie (as with Contains), and whether it is a proper
+    // prefix of at least one other element in the Trie (e.g. "car" when "card" exists), from a single search rather
+    // than separate calls to Contains and HasPrefix. Both are false for an element that is not accepted by the
+    // Digitizer of the Trie.
+    Lookup(element interface{}) (exact bool, isPrefix bool)
+
+    // RemoveAllWithPrefix removes all elements in the Trie that match the provided prefix, and returns the number of
+    // elements removed.
+    RemoveAllWithPrefix(prefix interface{}) int
+
+    // Split partitions the elements of the Trie by the provided prefix, returning a Trie containing the elements that
+    // match the prefix and a Trie containing the remaining elements. The Trie is left unchanged, and both returned Tries
+    // are of the same kind and use the same Digitizer as the Trie (see Trie.Clone). If the prefix is not accepted by the
+    // Digitizer, the matching Trie is empty and the rest Trie contains every element.
+    Split(prefix interface{}) (matching Trie, rest Trie)
+
+    // Range finds all elements in the Trie that are greater than or equal to the provided low element and less than the
+    // provided high element, and appends them (if any) to the provided collection in iteration order.
+    Range(low interface{}, high interface{}, collection collection.Collection)
 
     // LongestCommonPrefix finds all elements in the Trie that share the longest common prefix with the provided
     // element, and appends the matching elements (if any) to the provided collection.
     LongestCommonPrefix(element interface{}, collection collection.Collection)
+
+    // LongestPrefixOf returns the longest element in the Trie that is a prefix of the provided query (including the
+    // query itself), and true if such an element exists. Unlike LongestCommonPrefix, which collects the subtree sharing
+    // the longest prefix with an element, only the elements along the path of the query are considered (e.g. the
+    // longest route prefix matching a path). If no element is a prefix of the query, the return values will be nil and
+    // false.
+    LongestPrefixOf(query interface{}) (interface{}, bool)
+
+    // ApproximateMatch finds all elements in the Trie whose edit (Levenshtein) distance from the provided query is at
+    // most the provided maximum distance, and appends the matching elements (if any) to the provided collection. The
+    // distance is measured in digits, where each insertion, deletion, or substitution of a digit has a cost of one.
+    ApproximateMatch(query interface{}, maxDistance int, collection collection.Collection)
+
+    // WildcardMatch finds all elements in the Trie that match the provided pattern, and appends the matching elements
+    // (if any) to the provided collection. Within the pattern, a digit formatted as "?" matches any single digit, and a
+    // digit formatted as "*" matches any sequence of digits (including none).
+    WildcardMatch(pattern interface{}, collection collection.Collection)
+
+    // ValueWithIndex returns the element at the position specified by the provided index in the iteration order. The
+    // returned error will be non-nil if the provided index is outside the current bounds of the Trie
+    // (index < 0 || index > Trie.Size() - 1). Since the leaves of the Trie do not record their positions, the element
+    // is found by traversing the elements from the first, which is O(n) rather than the O(1) of an ArrayList.
+    ValueWithIndex(index int) (interface{}, error)
+
+    // MinIndex returns the position of Min in the iteration order, which is 0, or collection.ElementNotFound if the
+    // Trie is empty.
+    MinIndex() int
+
+    // MaxIndex returns the position of Max in the iteration order, which is Trie.Size() - 1, or
+    // collection.ElementNotFound if the Trie is empty.
+    MaxIndex() int
+
+    // RemoveMin removes the element returned by Min from the Trie and returns it. If the Trie is empty, the return value
+    // will be nil. The first leaf in iteration order is found in O(1), so repeatedly calling RemoveMin drains the Trie in
+    // ascending order without searching for each element.
+    RemoveMin() interface{}
+
+    // RemoveMax removes the element returned by Max from the Trie and returns it. If the Trie is empty, the return value
+    // will be nil.
+    RemoveMax() interface{}
+
+    // PredecessorFunc returns the element (if any) from the Trie that is less than the provided element, where the
+    // provided comparator, which returns true if a is less than b, breaks the tie between the provided element and an
+    // element of the Trie with the same digits (e.g. "Foo" and "foo" for a case-insensitive StringDigitizer). If the
+    // comparator finds the element with the same digits less than the provided element, it is returned, otherwise the
+    // result is the same as Predecessor. A nil comparator is equivalent to Predecessor.
+    PredecessorFunc(element interface{}, less func(a, b interface{}) bool) interface{}
+
+    // SuccessorFunc returns the element (if any) from the Trie that is greater than the provided element, where the
+    // provided comparator, which returns true if a is less than b, breaks the tie between the provided element and an
+    // element of the Trie with the same digits. If the comparator finds the provided element less than the element with
+    // the same digits, it is returned, otherwise the result is the same as Successor. A nil comparator is equivalent to
+    // Successor.
+    SuccessorFunc(element interface{}, less func(a, b interface{}) bool) interface{}
+
+    // Stream returns a channel over which the elements of the Trie are sent in iteration order, which is closed once
+    // every element has been sent. Unlike Values, the elements are not collected into a slice. The producer of the
+    // elements is blocked until they are received, so the channel should be drained (e.g. with range); to stop early,
+    // use collection.Stream with a done channel instead. The Trie must not be modified until the channel is closed.
+    Stream() <-chan interface{}
+
+    // Walk invokes the provided visitor for each element of the Trie in iteration order, until the visitor returns
+    // false.
+    Walk(visitor func(element interface{}) bool)
+
+    // ValuesDescending returns a slice containing the elements in the Trie in the reverse of the iteration order (i.e.
+    // the reverse of Values).
+    ValuesDescending() []interface{}
+
+    // ReverseIterator returns an Iterator positioned after the last element of the Trie, which traverses the elements of
+    // the Trie from the last to the first. As with Iterator, elements removed from the Trie during the traversal are
+    // skipped.
+    ReverseIterator() collection.Iterator
+
+    // Height returns the maximum depth of the leaves of the Trie, where the depth of a leaf is the number of edges
+    // between it and the root. The height of an empty Trie is 0.
+    Height() int
+
+    // AverageDepth returns the mean depth of the leaves of the Trie. The average depth of an empty Trie is 0.
+    AverageDepth() float64
+
+    // TreeString returns a string representation of the node hierarchy of the Trie, with one line per node indented by
+    // its depth. Each line is labelled with the formatted digit (Digitizer.FormatDigit) of the edge to its node, and the
+    // lines of leaf nodes are followed by their element.
+    TreeString() string
+
+    // DigitString returns a string representation of the Trie in which each element is rendered by concatenating its
+    // formatted digits (Digitizer.FormatDigit), excluding the end of key digit. Unlike String, which formats elements
+    // with fmt, this renders the raw digit encoding of the elements in the same form as TreeString (e.g. hexadecimal for
+    // byte slices), which is not necessarily a readable form of the elements. For example, the digits of an integer
+    // are the hexadecimal digits of its value with the sign bit inverted, so -7 is rendered as "7ffffffffffffff9".
+    DigitString() string
+
+    // Clone returns a new Trie of the same kind and with the same Digitizer containing the elements of the Trie. The
+    // structure of the returned Trie is independent of the Trie it was cloned from, while the elements themselves are
+    // shared (see collection.Collection).
+    Clone() collection.Collection
+}
+
+// TrieIterator is the collection.Iterator returned by Trie.Iterator. Elements removed from the Trie during the traversal
+// are skipped.
+type TrieIterator = collection.Iterator
+
+// operations defines the structural behavior of a trie that differs between variants (e.g. radixTree). The shared
+// behavior of a trie always invokes these through trie.operations, so that the variant embedding the trie is used.
+type operations interface {
+    find(element interface{}, sctx *searchContext) searchResult
+    resume(element interface{}, sctx *searchContext) searchResult
+    addNode(node Node, sctx *searchContext)
+    remove(node Node)
+    detach(node Node, element interface{}, level int)
+    createLeafNode() LeafNode
+}
+
+// dataCarrier defines the operations of the variants of the trie whose leaf nodes carry data besides their elements
+// (e.g. the weight of an element of a WeightedTrie), so that the data is retained when the trie is encoded.
+type dataCarrier interface {
+    dataOf(leafNode LeafNode) interface{}
+    leafNodeWith(element interface{}, data interface{}) (LeafNode, error)
 }
 
 type trie struct {
-    root      Node
-    head      LeafNode
-    tail      LeafNode
-    digitizer Digitizer
-    capacity  int
-    base      int
-    size      int
+    root       Node
+    head       LeafNode
+    tail       LeafNode
+    digitizer  Digitizer
+    operations operations
+    capacity   int
+    base       int
+    size       int
+    sparse     bool
 }
 
 func newTrie(capacity int) *trie {
@@ -43,13 +230,17 @@ func newTrieWithDigitizer(digitizer Digitizer) *trie {
 
     head.SetNext(tail)
     tail.SetNext(head)
+    tail.SetPrevious(head)
 
-    return &trie{
+    t := &trie{
         head:      head,
         tail:      tail,
         digitizer: digitizer,
         capacity:  capacity,
     }
+    t.operations = t
+
+    return t
 }
 
 // NewTrie creates a new Trie with the provided capacity. The capacity is used to set the base (or range of digits) used
@@ -59,35 +250,135 @@ func NewTrie(capacity int) Trie {
     return newTrie(capacity)
 }
 
+// FromCollection creates a new Trie with the provided capacity (see NewTrie) containing the elements of the provided
+// collection. The returned error will be non-nil if any element is not accepted by the StringDigitizer of the Trie or
+// violates the prefix-free requirement, in which case the returned Trie will be nil.
+func FromCollection(collection collection.Collection, capacity int) (Trie, error) {
+    t := newTrie(capacity)
+    if err := t.AddAll(collection); err != nil {
+        return nil, err
+    }
+
+    return t, nil
+}
+
 // NewTrieWithDigitizer
 func NewTrieWithDigitizer(digitizer Digitizer) Trie {
     return newTrieWithDigitizer(digitizer)
 }
 
-// Add inserts the provided element into the Trie. The returned error will be non-nil for bounded Collection
-// implementations that have reached capacity and cannot hold any further elements.
+// NewSparseTrieWithDigitizer creates a new Trie whose nodes store their children in a map rather than a slice with an
+// entry for every digit. This reduces the memory used by a Trie with a large base (e.g. NewByteDigitizer()) whose nodes
+// have few children, at the cost of slower access to the children of a node.
+func NewSparseTrieWithDigitizer(digitizer Digitizer) Trie {
+    t := newTrieWithDigitizer(digitizer)
+    t.sparse = true
+
+    return t
+}
+
+// Add inserts the provided element into the Trie. The returned error will be non-nil if the element is not accepted by
+// the Digitizer of the Trie, or for bounded Collection implementations that have reached capacity and cannot hold any
+// further elements.
 func (t *trie) Add(element interface{}) error {
     _, err := t.insert(element)
 
     return err
 }
 
-// AddAll inserts all elements from the provided collection into the Trie. The returned error will be non-nil
-// for bounded Collection implementations that have reached capacity and cannot hold any further elements.
+// AddWithPosition inserts the provided element into the trie, and returns the position of its leaf node in the
+// iteration order, which is counted by retreating from the leaf node to the head.
+func (t *trie) AddWithPosition(element interface{}) (int, error) {
+    leafNode, err := t.insert(element)
+    if err != nil {
+        return collection.ElementNotFound, err
+    }
+
+    return positionOf(leafNode.(LeafNode)), nil
+}
+
+// AddAll inserts all elements from the provided collection into the Trie. The returned error will be non-nil if any
+// element is not accepted by the Digitizer of the Trie or violates the prefix-free requirement, in which case the
+// elements inserted before the failure are removed so that the Trie is left unchanged.
 func (t *trie) AddAll(collection collection.Collection) error {
-    if collection != nil {
-        for _, v := range collection.Values() {
-            if err := t.Add(v); err != nil {
-                return err
+    if collection == nil {
+        return nil
+    }
+
+    values := collection.Values()
+    for i, v := range values {
+        if err := t.Add(v); err != nil {
+            for j := i - 1; j >= 0; j-- {
+                t.Remove(values[j])
+            }
+
+            return err
+        }
+    }
+
+    return nil
+}
+
+// Merge inserts the elements of the provided Trie that do not already exist in the trie. The elements of the provided
+// Trie are inserted in iteration order, so each insertion usually shares its path with the previous one.
+func (t *trie) Merge(other Trie) error {
+    if err := t.checkMergeable(other); err != nil || other == nil {
+        return err
+    }
+
+    added := make([]interface{}, 0, other.Size())
+    for _, element := range other.Values() {
+        if t.Contains(element) {
+            continue
+        }
+
+        if err := t.Add(element); err != nil {
+            for i := len(added) - 1; i >= 0; i-- {
+                t.Remove(added[i])
+            }
+
+            return err
+        }
+        added = append(added, element)
+    }
+
+    return nil
+}
+
+// BulkAdd inserts all of the provided elements into the trie in the order of their digits. The search for each element
+// resumes from the longest prefix it shares with the previously inserted element, and since the previously inserted
+// element is usually its predecessor, the element is linked into the iteration order without searching for it.
+func (t *trie) BulkAdd(elements []interface{}) error {
+    for _, element := range elements {
+        if err := t.validate(element); err != nil {
+            return err
+        }
+    }
+
+    sorted := t.sortByDigits(elements)
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    var previous LeafNode
+    for i, element := range sorted {
+        leafNode, err := t.insertAfter(element, previous, sctx)
+        if err != nil {
+            for j := i - 1; j >= 0; j-- {
+                t.Remove(sorted[j])
             }
+
+            return err
         }
+        previous = leafNode
     }
 
     return nil
 }
 
 // ValueWithIndex returns the element at the position specified by the provided index. The returned error will be
-// non-nil if the provided index is outside the current bounds of the trie (index < 0 || index > trie.Size() - 1).
+// non-nil if the provided index is outside the current bounds of the trie (index < 0 || index > trie.Size() - 1). The
+// element is found in O(n) by advancing through the elements from the first.
 func (t *trie) ValueWithIndex(index int) (interface{}, error) {
     if err := t.checkBounds(index); err != nil {
         return nil, err
@@ -104,22 +395,34 @@ func (t *trie) ValueWithIndex(index int) (interface{}, error) {
 // Remove removes the first occurrence (if any) of an element equivalent to the provided element. If an element was
 // removed, the return value will be true, otherwise false will be returned.
 func (t *trie) Remove(element interface{}) bool {
-    if t.IsEmpty() {
+    if t.IsEmpty() || !t.accepts(element) {
         return false
     }
 
     sctx := acquireSearchContext()
     defer releaseSearchContext(sctx)
 
-    if t.find(element, sctx) != Matched {
+    if t.operations.find(element, sctx) != Matched {
         return false
     }
 
-    t.remove(sctx.pointer)
+    t.operations.remove(sctx.pointer)
 
     return true
 }
 
+// RemoveAll removes every occurrence of each element of the provided collection from the Trie, and returns the number
+// of elements removed. A nil collection removes no elements.
+func (t *trie) RemoveAll(other collection.Collection) int {
+    return collection.RemoveAll(t, other)
+}
+
+// RetainAll removes every element from the Trie that does not exist in the provided collection, and returns the number
+// of elements removed. A nil collection is treated as an empty collection.
+func (t *trie) RetainAll(other collection.Collection) int {
+    return collection.RetainAll(t, other)
+}
+
 // Min returns the element with the lowest position in the Trie. More specifically, the first element in the iteration
 // order is returned.
 func (t *trie) Min() interface{} {
@@ -140,14 +443,54 @@ func (t *trie) Max() interface{} {
     return nil
 }
 
+// MinIndex returns the position of the element returned by Min, which is 0, or collection.ElementNotFound if the trie is
+// empty.
+func (t *trie) MinIndex() int {
+    if t.IsEmpty() {
+        return collection.ElementNotFound
+    }
+
+    return 0
+}
+
+// MaxIndex returns the position of the element returned by Max, which is trie.Size() - 1, or collection.ElementNotFound
+// if the trie is empty.
+func (t *trie) MaxIndex() int {
+    if t.IsEmpty() {
+        return collection.ElementNotFound
+    }
+
+    return t.Size() - 1
+}
+
+// RemoveMin removes the first element in the iteration order from the trie and returns it, or nil if the trie is empty.
+// The leaf of the element is the first in the leaf linked list, so it is removed without searching for the element.
+func (t *trie) RemoveMin() interface{} {
+    if t.IsEmpty() {
+        return nil
+    }
+
+    return t.removeLeafNode(t.head.Next())
+}
+
+// RemoveMax removes the last element in the iteration order from the trie and returns it, or nil if the trie is empty.
+// The leaf of the element is the last in the leaf linked list, so it is removed without searching for the element.
+func (t *trie) RemoveMax() interface{} {
+    if t.IsEmpty() {
+        return nil
+    }
+
+    return t.removeLeafNode(t.tail.Previous())
+}
+
 // Predecessor returns the element (if any) from the Trie that is less than the provided element. More specifically, the
 // element before the first occurrence of the provided element in iteration order is returned.
 func (t *trie) Predecessor(element interface{}) interface{} {
-    if !t.IsEmpty() {
+    if !t.IsEmpty() && t.accepts(element) {
         sctx := acquireSearchContext()
         defer releaseSearchContext(sctx)
 
-        if t.moveToPredecessor(element, sctx, t.find(element, sctx)) {
+        if t.moveToPredecessor(element, sctx, t.operations.find(element, sctx)) {
             return sctx.pointer.Value()
         }
     }
@@ -158,122 +501,705 @@ func (t *trie) Predecessor(element interface{}) interface{} {
 // Successor returns the element (if any) from the Trie that is greater than the provided element. More specifically,
 // the element after the first occurrence of the provided element in iteration order is returned.
 func (t *trie) Successor(element interface{}) interface{} {
-    if !t.IsEmpty() {
+    if !t.IsEmpty() && t.accepts(element) {
+        sctx := acquireSearchContext()
+        defer releaseSearchContext(sctx)
+
+        searchResult := t.operations.find(element, sctx)
+        successor    := t.head.Next()
+        if searchResult == Matched || t.moveToPredecessor(element, sctx, searchResult) {
+            successor = sctx.pointer.(LeafNode).Next()
+        }
+
+        if !successor.IsTail() {
+            return successor.Value()
+        }
+    }
+
+    return nil
+}
+
+// PredecessorFunc returns the element (if any) from the Trie that is less than the provided element, where the provided
+// comparator breaks the tie between the provided element and an element of the Trie with the same digits. A nil
+// comparator falls back to the digit order of Predecessor.
+func (t *trie) PredecessorFunc(element interface{}, less func(a, b interface{}) bool) interface{} {
+    if less == nil {
+        return t.Predecessor(element)
+    }
+
+    if !t.IsEmpty() && t.accepts(element) {
+        sctx := acquireSearchContext()
+        defer releaseSearchContext(sctx)
+
+        searchResult := t.operations.find(element, sctx)
+        if searchResult == Matched && less(sctx.pointer.Value(), element) {
+            return sctx.pointer.Value()
+        }
+
+        if t.moveToPredecessor(element, sctx, searchResult) {
+            return sctx.pointer.Value()
+        }
+    }
+
+    return nil
+}
+
+// SuccessorFunc returns the element (if any) from the Trie that is greater than the provided element, where the provided
+// comparator breaks the tie between the provided element and an element of the Trie with the same digits. A nil
+// comparator falls back to the digit order of Successor.
+func (t *trie) SuccessorFunc(element interface{}, less func(a, b interface{}) bool) interface{} {
+    if less == nil {
+        return t.Successor(element)
+    }
+
+    if !t.IsEmpty() && t.accepts(element) {
+        sctx := acquireSearchContext()
+        defer releaseSearchContext(sctx)
+
+        searchResult := t.operations.find(element, sctx)
+        successor    := t.head.Next()
+        if searchResult == Matched {
+            successor = sctx.pointer.(LeafNode)
+            if !less(element, successor.Value()) {
+                successor = successor.Next()
+            }
+        } else if t.moveToPredecessor(element, sctx, searchResult) {
+            successor = sctx.pointer.(LeafNode).Next()
+        }
+
+        if !successor.IsTail() {
+            return successor.Value()
+        }
+    }
+
+    return nil
+}
+
+// Floor returns the element (if any) from the trie that is less than or equal to the provided element. If the provided
+// element exists in the trie it is returned, otherwise its predecessor is returned.
+func (t *trie) Floor(element interface{}) interface{} {
+    if !t.IsEmpty() && t.accepts(element) {
+        sctx := acquireSearchContext()
+        defer releaseSearchContext(sctx)
+
+        searchResult := t.operations.find(element, sctx)
+        if searchResult == Matched || t.moveToPredecessor(element, sctx, searchResult) {
+            return sctx.pointer.Value()
+        }
+    }
+
+    return nil
+}
+
+// Ceiling returns the element (if any) from the trie that is greater than or equal to the provided element. If the
+// provided element exists in the trie it is returned, otherwise its successor is returned.
+func (t *trie) Ceiling(element interface{}) interface{} {
+    if !t.IsEmpty() && t.accepts(element) {
+        sctx := acquireSearchContext()
+        defer releaseSearchContext(sctx)
+
+        if ceiling := t.ceiling(element, sctx); !ceiling.IsTail() {
+            return ceiling.Value()
+        }
+    }
+
+    return nil
+}
+
+// Completions finds all elements in the trie that match the provided prefix, and appends the matching elements (if any)
+// to the provided collection.
+func (t *trie) Completions(prefix interface{}, collection collection.Collection) {
+    if !t.IsEmpty() && t.acceptsPrefix(prefix) {
+        sctx := acquireSearchContext()
+        defer releaseSearchContext(sctx)
+
+        if t.findCompletions(prefix, sctx) {
+            sctx.elementsInSubtree(collection)
+        }
+    }
+
+    return
+}
+
+// CompletionsLimit finds (at most) the first limit elements in the trie that match the provided prefix, and appends the
+// matching elements (if any) to the provided collection. The matching elements are traversed along the leaf nodes from
+// the first leaf of the subtree containing them, so only the leaves of the elements found are visited.
+func (t *trie) CompletionsLimit(prefix interface{}, limit int, collection collection.Collection) {
+    if t.IsEmpty() || limit <= 0 || !t.acceptsPrefix(prefix) {
+        return
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    if !t.findCompletions(prefix, sctx) {
+        return
+    }
+
+    first, last := sctx.leafNodesInSubtree()
+    for leafNode, count := first, 1; ; leafNode, count = leafNode.Next(), count + 1 {
+        _ = collection.Add(leafNode.Value())
+
+        if leafNode == last || count == limit {
+            break
+        }
+    }
+}
+
+// CountCompletions returns the number of elements in the trie that match the provided prefix by counting the leaves of
+// the subtree containing the matching elements, without collecting the elements.
+func (t *trie) CountCompletions(prefix interface{}) int {
+    if t.IsEmpty() || !t.acceptsPrefix(prefix) {
+        return 0
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    if t.findCompletions(prefix, sctx) {
+        return sctx.numElementsInSubtree()
+    }
+
+    return 0
+}
+
+// HasPrefix returns true if at least one element in the trie matches the provided prefix, otherwise false is returned.
+// The subtree containing the matching elements is located, but not traversed.
+func (t *trie) HasPrefix(prefix interface{}) bool {
+    if t.IsEmpty() || !t.acceptsPrefix(prefix) {
+        return false
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    return t.findCompletions(prefix, sctx)
+}
+
+// Lookup returns whether the provided element exists in the trie, and whether it is a proper prefix of another element
+// in the trie. The subtree containing the elements that match the element is located with the same search used to find
+// the element, and the element is a proper prefix if the subtree contains any other element, which is determined from
+// its first and last leaves without traversing it.
+func (t *trie) Lookup(element interface{}) (bool, bool) {
+    if t.IsEmpty() || !t.accepts(element) {
+        return false, false
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    searchResult := t.operations.find(element, sctx)
+    exact        := searchResult == Matched
+    if !t.moveToCompletions(element, searchResult, sctx) {
+        return exact, false
+    }
+
+    first, last := sctx.leafNodesInSubtree()
+
+    return exact, !exact || first != last
+}
+
+// RemoveAllWithPrefix removes all elements in the trie that match the provided prefix, and returns the number of
+// elements removed. The elements matching the prefix are contiguous in iteration order, so the subtree containing them
+// is detached from the trie and the range of leaf nodes is spliced out of the iteration order.
+func (t *trie) RemoveAllWithPrefix(prefix interface{}) int {
+    if t.IsEmpty() || !t.acceptsPrefix(prefix) {
+        return 0
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    if !t.findCompletions(prefix, sctx) {
+        return 0
+    }
+
+    subtree     := sctx.pointer
+    level       := sctx.branchPosition
+    count       := sctx.numElementsInSubtree()
+    first, last := sctx.leafNodesInSubtree()
+
+    for leafNode := first; ; {
+        next := leafNode.Next()
+        leafNode.Remove()
+        if leafNode == last {
+            break
+        }
+        leafNode = next
+    }
+
+    if subtree.IsRoot() {
+        t.root = nil
+    } else {
+        t.operations.detach(subtree, prefix, level)
+    }
+
+    t.size -= count
+
+    return count
+}
+
+// Split partitions the elements of the trie by the provided prefix into two clones of the trie, so that both are of the
+// same kind as the variant embedding the trie (e.g. a radix tree). The elements matching the prefix are contiguous in
+// iteration order, so they are spliced out of one clone as with RemoveAllWithPrefix, while the leaf nodes before and
+// after them are removed from the other.
+func (t *trie) Split(prefix interface{}) (Trie, Trie) {
+    matching, m := t.cloneOfKind()
+    rest, r     := t.cloneOfKind()
+
+    m.retainAllWithPrefix(prefix)
+    r.RemoveAllWithPrefix(prefix)
+
+    return matching, rest
+}
+
+// Range finds all elements in the trie that are greater than or equal to the provided low element and less than the
+// provided high element, and appends them (if any) to the provided collection in iteration order. The first element of
+// the range is located with a search, after which the range is traversed along the leaf nodes. No elements are appended
+// if either bound is not accepted by the Digitizer of the trie.
+func (t *trie) Range(low interface{}, high interface{}, collection collection.Collection) {
+    if t.IsEmpty() || !t.accepts(low) || !t.accepts(high) || t.digitizer.Compare(low, high) >= 0 {
+        return
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    last := t.ceiling(high, sctx)
+    for leafNode := t.ceiling(low, sctx); leafNode != last && !leafNode.IsTail(); leafNode = leafNode.Next() {
+        _ = collection.Add(leafNode.Value())
+    }
+}
+
+// LongestCommonPrefix finds all elements in the trie that share the longest common prefix with the provided element,
+// and appends the matching elements (if any) to the provided collection.
+func (t *trie) LongestCommonPrefix(prefix interface{}, collection collection.Collection) {
+    if !t.IsEmpty() && t.acceptsPrefix(prefix) {
         sctx := acquireSearchContext()
         defer releaseSearchContext(sctx)
 
-        searchResult := t.find(element, sctx)
-        successor    := t.tail
-        if searchResult == Matched {
-            successor = sctx.pointer.(LeafNode).Next()
-        } else if t.moveToPredecessor(element, sctx, t.find(element, sctx)) {
-            successor = sctx.pointer.(LeafNode).Next()
-        }
+        t.operations.find(prefix, sctx)
+        if sctx.processedEndOfString(prefix) {
+            sctx.ascend()
+        }
+        sctx.elementsInSubtree(collection)
+    }
+
+    return
+}
+
+// LongestPrefixOf returns the longest element in the trie that is a prefix of the provided query, and true if such an
+// element exists. The digits of the query are descended from the root, and the deepest element encountered along the
+// way (i.e. the element ending at the end of string child of a node on the path, or the leaf the descent ends at) that
+// is a prefix of the query is returned. Since a leaf may be placed above the depth of its last digit, each element
+// encountered is compared with the query before it is accepted.
+func (t *trie) LongestPrefixOf(query interface{}) (interface{}, bool) {
+    if t.IsEmpty() || !t.accepts(query) {
+        return nil, false
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    t.prepareSearch(sctx)
+
+    var longest interface{}
+    found            := false
+    numDigitsInQuery := t.digitizer.NumDigitsOf(query)
+    consider         := func(node Node) {
+        if element := node.Value(); t.commonPrefixLength(query, element) >= t.numDigitsWithoutEndOf(element) {
+            longest, found = element, true
+        }
+    }
+
+    for !sctx.atLeaf() {
+        if t.digitizer.IsPrefixFree() {
+            if child, err := sctx.pointer.ChildWithIndexOf(0); err == nil && child != nil && child.IsLeaf() {
+                consider(child)
+            }
+        }
+
+        if sctx.branchPosition == numDigitsInQuery || sctx.descendTo(query) == childNotFound {
+            return longest, found
+        }
+    }
+    consider(sctx.pointer)
+
+    return longest, found
+}
+
+// ApproximateMatch finds all elements in the trie whose edit (Levenshtein) distance from the provided query is at most
+// the provided maximum distance, and appends the matching elements (if any) to the provided collection. A row of the
+// edit distance table is computed for each node along the way, and subtrees are pruned once every entry in the row
+// exceeds the maximum distance.
+func (t *trie) ApproximateMatch(query interface{}, maxDistance int, collection collection.Collection) {
+    if t.IsEmpty() || maxDistance < 0 || !t.accepts(query) {
+        return
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    t.prepareSearch(sctx)
+
+    digits := make([]int, t.numDigitsWithoutEndOf(query))
+    row    := make([]int, len(digits) + 1)
+    for i := range digits {
+        digits[i] = t.digitizer.DigitOf(query, i)
+    }
+    for i := range row {
+        row[i] = i
+    }
+
+    sctx.approximateMatches(digits, row, maxDistance, collection)
+}
+
+// WildcardMatch finds all elements in the trie that match the provided pattern, and appends the matching elements (if
+// any) to the provided collection. Within the pattern, a digit formatted as "?" matches any single digit, and a digit
+// formatted as "*" matches any sequence of digits (including none). The set of pattern positions reachable along the
+// path to each node is tracked during the traversal, and subtrees are pruned once no positions are reachable.
+func (t *trie) WildcardMatch(pattern interface{}, collection collection.Collection) {
+    if t.IsEmpty() || !t.acceptsPattern(pattern) {
+        return
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    t.prepareSearch(sctx)
+
+    tokens := make([]int, t.numDigitsWithoutEndOf(pattern))
+    for i := range tokens {
+        switch t.digitizer.FormatDigit(pattern, i) {
+        case "?":
+            tokens[i] = anyDigit
+        case "*":
+            tokens[i] = anySequence
+        default:
+            tokens[i] = t.digitizer.DigitOf(pattern, i)
+        }
+    }
+
+    states := make([]bool, len(tokens) + 1)
+    states[0] = true
+
+    sctx.wildcardMatches(tokens, closureOf(tokens, states), collection)
+}
+
+// Iterator returns an Iterator positioned before the first element of the Trie in iteration order. Elements removed from
+// the Trie during the traversal are skipped.
+func (t *trie) Iterator() collection.Iterator {
+    return newIterator(t, t.head)
+}
+
+// ReverseIterator returns an Iterator positioned after the last element of the Trie, which traverses the leaf nodes from
+// the tail back to the head. Elements removed from the Trie during the traversal are skipped.
+func (t *trie) ReverseIterator() collection.Iterator {
+    return &reverseIterator{ iterator: newIterator(t, t.tail) }
+}
+
+// Stream returns a channel over which the elements of the trie are sent in iteration order, which is closed once every
+// element has been sent. The trie must not be modified until the channel is closed.
+func (t *trie) Stream() <-chan interface{} {
+    return collection.Stream(t, nil)
+}
+
+// Walk invokes the provided visitor for each element of the trie in iteration order, until the visitor returns false.
+func (t *trie) Walk(visitor func(element interface{}) bool) {
+    iterator := newIterator(t, t.head)
+    for iterator.advance() {
+        if !visitor(iterator.get()) {
+            return
+        }
+    }
+}
+
+// Height returns the maximum depth of the leaves of the trie, where the depth of a leaf is the number of edges between
+// it and the root. The height of an empty trie is 0.
+func (t *trie) Height() int {
+    height, _ := t.depths()
+
+    return height
+}
+
+// AverageDepth returns the mean depth of the leaves of the trie. The average depth of an empty trie is 0.
+func (t *trie) AverageDepth() float64 {
+    if t.IsEmpty() {
+        return 0
+    }
+
+    _, sum := t.depths()
+
+    return float64(sum) / float64(t.Size())
+}
+
+// Clone returns a new trie with the same Digitizer containing the elements of the trie.
+func (t *trie) Clone() collection.Collection {
+    clone := newTrieWithDigitizer(t.digitizer)
+    t.copyTo(clone)
+
+    return clone
+}
+
+// Size returns the number of elements in the Trie.
+func (t *trie) Size() int {
+    return t.size
+}
+
+// IsEmpty returns true if the Trie contains no elements, otherwise false is returned.
+func (t *trie) IsEmpty() bool {
+    return t.Size() == 0
+}
+
+// Clear removes all elements from the Trie.
+func (t *trie) Clear() {
+    iterator := newIterator(t, t.head)
+    for iterator.advance() {
+        iterator.remove()
+    }
+}
+
+// Contains returns true if an element equivalent to the provided element exists in the Trie, otherwise false is
+// returned. Since the digits of every element end with the end of key digit, an element that is a prefix of another
+// element (e.g. "da" and "dab") is stored at its own leaf, so a search for a stored element always ends with Matched
+// rather than Prefix.
+func (t *trie) Contains(element interface{}) bool {
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    return t.contains(element, sctx)
+}
+
+// ContainsAll returns true if every element of the provided collection exists in the Trie, otherwise false is returned.
+// A nil or empty collection is contained by every Trie.
+func (t *trie) ContainsAll(other collection.Collection) bool {
+    return collection.ContainsAll(t, other)
+}
+
+// ContainsAllOf returns a map from each of the provided elements to true if it exists in the Trie, otherwise false. A
+// single search context is shared by the searches for all of the elements. Elements that are not hashable are omitted.
+func (t *trie) ContainsAllOf(elements []interface{}) map[interface{}]bool {
+    membership := make(map[interface{}]bool, len(elements))
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    for _, element := range elements {
+        if !collection.IsHashable(element) {
+            continue
+        }
+
+        if _, ok := membership[element]; !ok {
+            membership[element] = t.contains(element, sctx)
+        }
+    }
+
+    return membership
+}
+
+// Values returns a slice containing the elements in the trie in the iteration order.
+func (t *trie) Values() []interface{} {
+    elements := make([]interface{}, 0)
+    iterator := newIterator(t, t.head)
+    for iterator.advance() {
+        elements = append(elements, iterator.get())
+    }
+
+    return elements
+}
+
+// ValuesDescending returns a slice containing the elements in the trie in the reverse of the iteration order, which are
+// collected by traversing the leaf nodes from the tail back to the head.
+func (t *trie) ValuesDescending() []interface{} {
+    elements := make([]interface{}, 0, t.Size())
+    iterator := newIterator(t, t.tail)
+    for iterator.retreat() {
+        elements = append(elements, iterator.get())
+    }
+
+    return elements
+}
+
+// MarshalBinary encodes the base of the Digitizer and the elements of the trie in iteration order using encoding/gob,
+// along with the data carried by the leaf node of each element for the variants whose leaf nodes carry data (e.g. the
+// weight of each element of a WeightedTrie). Elements and data of a type other than the predeclared types must be
+// registered with gob.Register.
+func (t *trie) MarshalBinary() ([]byte, error) {
+    var buffer bytes.Buffer
+
+    snapshot := trieSnapshot{ Base: t.digitizer.Base(), Elements: t.Values() }
+    if carrier, ok := t.operations.(dataCarrier); ok {
+        snapshot.Data = make([]leafData, 0, t.Size())
 
-        if !successor.IsTail() {
-            return successor.Value()
+        iterator := newIterator(t, t.head)
+        for iterator.advance() {
+            snapshot.Data = append(snapshot.Data, leafData{ Value: carrier.dataOf(iterator.pointer) })
         }
     }
 
-    return nil
+    if err := gob.NewEncoder(&buffer).Encode(snapshot); err != nil {
+        return nil, errors.Wrap(err, "could not encode trie")
+    }
+
+    return buffer.Bytes(), nil
 }
 
-// Completions finds all elements in the trie that match the provided prefix, and appends the matching elements (if any)
-// to the provided collection.
-func (t *trie) Completions(prefix interface{}, collection collection.Collection) {
-    if !t.IsEmpty() {
-        sctx := acquireSearchContext()
-        defer releaseSearchContext(sctx)
+// UnmarshalBinary replaces the elements of the trie with the elements decoded from the provided data, which must have
+// been encoded by MarshalBinary for a trie whose Digitizer has the same base. The node structure is rebuilt by inserting
+// each decoded element into an empty trie of the same kind, which replaces the contents of the trie only once every
+// element has been inserted. The decoded data of each element is restored to its leaf node for the variants whose leaf
+// nodes carry data, unless the data was encoded by a trie whose leaf nodes do not carry data. The trie is left unchanged
+// if the returned error is non-nil (e.g. if a decoded element is not accepted by the Digitizer of the trie).
+func (t *trie) UnmarshalBinary(data []byte) error {
+    var snapshot trieSnapshot
+    if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&snapshot); err != nil {
+        return errors.Wrap(err, "could not decode trie")
+    }
 
-        searchResult := t.find(prefix, sctx)
-        numDigits    := t.digitizer.NumDigitsOf(prefix)
-        if t.digitizer.IsPrefixFree() {
-            numDigits--
-            if sctx.processedEndOfString(prefix) {
-                sctx.ascend()
-            }
+    if snapshot.Base != t.digitizer.Base() {
+        return errors.Errorf("digitizer base mismatch [Digitizer.Base() = %v, decoded base = %v]", t.digitizer.Base(), snapshot.Base)
+    }
+
+    _, decoded := t.cloneOfKind()
+    decoded.Clear()
+
+    carrier, ok := decoded.operations.(dataCarrier)
+    if ok && len(snapshot.Data) > 0 {
+        if len(snapshot.Data) != len(snapshot.Elements) {
+            return errors.Errorf("decoded data mismatch [elements = %v, data = %v]", len(snapshot.Elements), len(snapshot.Data))
         }
 
-        if searchResult == Prefix || searchResult == Matched || sctx.branchPosition == numDigits {
-            sctx.elementsInSubtree(collection)
+        for i, element := range snapshot.Elements {
+            leafNode, err := carrier.leafNodeWith(element, snapshot.Data[i].Value)
+            if err != nil {
+                return err
+            }
+
+            if _, err := decoded.insertLeafNode(leafNode); err != nil {
+                return err
+            }
+        }
+    } else {
+        for _, element := range snapshot.Elements {
+            if _, err := decoded.insert(element); err != nil {
+                return err
+            }
         }
     }
+    t.replaceWith(decoded)
 
-    return
+    return nil
 }
 
-// LongestCommonPrefix finds all elements in the trie that share the longest common prefix with the provided element,
-// and appends the matching elements (if any) to the provided collection.
-func (t *trie) LongestCommonPrefix(prefix interface{}, collection collection.Collection) {
+// GobEncode encodes the trie for the encoding/gob package in the same form as MarshalBinary.
+func (t *trie) GobEncode() ([]byte, error) {
+    return t.MarshalBinary()
+}
+
+// GobDecode replaces the elements of the trie with the elements decoded from the provided data in the same manner as
+// UnmarshalBinary.
+func (t *trie) GobDecode(data []byte) error {
+    return t.UnmarshalBinary(data)
+}
+
+// TreeString returns a string representation of the node hierarchy of the Trie in it's current state. The first line
+// represents the root, and each following line represents a node labelled with the formatted digit of its edge.
+func (t *trie) TreeString() string {
+    var builder strings.Builder
+    builder.WriteString(".")
+
     if !t.IsEmpty() {
         sctx := acquireSearchContext()
         defer releaseSearchContext(sctx)
 
-        t.find(prefix, sctx)
-        if sctx.processedEndOfString(prefix) {
-            sctx.ascend()
-        }
-        sctx.elementsInSubtree(collection)
+        t.prepareSearch(sctx)
+        sctx.formatSubtree(&builder, "")
     }
 
-    return
+    return builder.String()
 }
 
-// Size returns the number of elements in the Trie.
-func (t *trie) Size() int {
-    return t.size
+// String returns a string representation of the Trie in it's current state.
+func (t *trie) String() string {
+    return collection.Format(t)
 }
 
-// IsEmpty returns true if the Trie contains no elements, otherwise false is returned.
-func (t *trie) IsEmpty() bool {
-    return t.Size() == 0
+// DigitString returns a string representation of the Trie in it's current state in which each element is rendered from
+// the formatted digits of its digit places, which is the raw digit encoding of the element rather than its value.
+func (t *trie) DigitString() string {
+    if t.Size() == 0 {
+        return "[]"
+    }
+
+    elements := make([]string, 0, t.Size())
+    locator  := newIterator(t, t.head)
+    for locator.advance() {
+        elements = append(elements, t.formatElement(locator.get()))
+    }
+
+    return "[" + strings.Join(elements, ", ") + "]"
 }
 
-// Clear removes all elements from the Trie.
-func (t *trie) Clear() {
-    iterator := newIterator(t, t.head)
-    for iterator.advance() {
-        iterator.remove()
+// formatElement returns the concatenation of the formatted digits of the provided element, excluding the end of key
+// digit of a prefix free Digitizer.
+func (t *trie) formatElement(element interface{}) string {
+    var builder strings.Builder
+    for place := 0; place < t.numDigitsWithoutEndOf(element); place++ {
+        builder.WriteString(t.digitizer.FormatDigit(element, place))
     }
+
+    return builder.String()
 }
 
-// Contains returns true if an element equivalent to the provided element exists in the Trie, otherwise false is
-// returned.
-func (t *trie) Contains(element interface{}) bool {
+// depths returns the maximum and the sum of the depths of the leaves of the trie.
+func (t *trie) depths() (int, int) {
     if t.IsEmpty() {
-        return false
+        return 0, 0
     }
 
     sctx := acquireSearchContext()
     defer releaseSearchContext(sctx)
 
-    return t.find(element, sctx) == Matched
+    t.prepareSearch(sctx)
+
+    return sctx.depthsInSubtree()
 }
 
-// Values returns a slice containing the elements in the trie in the iteration order.
-func (t *trie) Values() []interface{} {
-    elements := make([]interface{}, 0)
+// copyTo inserts the elements of the trie into the provided trie, which is assumed to be empty and to have an equivalent
+// Digitizer.
+func (t *trie) copyTo(target *trie) {
+    target.sparse = t.sparse
+
     iterator := newIterator(t, t.head)
     for iterator.advance() {
-        elements = append(elements, iterator.get())
+        _, _ = target.insert(iterator.get())
     }
-
-    return elements
 }
 
-// String returns a string representation of the Trie in it's current state.
-func (t *trie) String() string {
-    if t.Size() == 0 {
-        return "[]"
+// positionOf returns the position in iteration order of the provided leaf node, which must not have been removed.
+func positionOf(leafNode LeafNode) int {
+    position := 0
+    for previous := leafNode.Previous(); !previous.IsHead(); previous = previous.Previous() {
+        position++
     }
 
-    elements := make([]string, 0, t.Size())
-    locator  := newIterator(t, t.head)
-    for locator.advance() {
-        elements = append(elements, fmt.Sprintf("%v", locator.get()))
+    return position
+}
+
+// checkMergeable returns a non-nil error if the provided Trie is implemented by this package and its Digitizer has a
+// different base than the Digitizer of the trie. The elements of a Trie implemented elsewhere are validated as they are
+// inserted.
+func (t *trie) checkMergeable(other Trie) error {
+    if o, ok := other.(interface{ digitizerOf() Digitizer }); ok && o.digitizerOf().Base() != t.digitizer.Base() {
+        return errors.Errorf("digitizer base mismatch [Digitizer.Base() = %v, other base = %v]", t.digitizer.Base(), o.digitizerOf().Base())
     }
 
-    return "[" + strings.Join(elements, ", ") + "]"
+    return nil
+}
+
+// digitizerOf returns the Digitizer of the trie.
+func (t *trie) digitizerOf() Digitizer {
+    return t.digitizer
 }
 
 func (t *trie) checkBounds(index int) error {
@@ -284,6 +1210,15 @@ func (t *trie) checkBounds(index int) error {
     return nil
 }
 
+// contains returns true if the provided element exists in the trie, using the provided search context.
+func (t *trie) contains(element interface{}, sctx *searchContext) bool {
+    if t.IsEmpty() || !t.accepts(element) {
+        return false
+    }
+
+    return t.operations.find(element, sctx) == Matched
+}
+
 func (t *trie) find(element interface{}, sctx *searchContext) searchResult {
     t.prepareSearch(sctx)
 
@@ -291,13 +1226,20 @@ func (t *trie) find(element interface{}, sctx *searchContext) searchResult {
         return Unmatched
     }
 
+    return t.resume(element, sctx)
+}
+
+// resume continues the search for the provided element from the current node of the provided search context, which
+// must be on the path of the element.
+func (t *trie) resume(element interface{}, sctx *searchContext) searchResult {
     numDigitsInElement := t.digitizer.NumDigitsOf(element)
+    digits             := batchDigitsOf(t.digitizer, element)
 
     for sctx.pointer != nil && !sctx.atLeaf() {
         switch {
         case sctx.branchPosition == numDigitsInElement:
             return Prefix
-        case sctx.descendTo(element) == childNotFound:
+        case sctx.descendToDigitOf(element, digits) == childNotFound:
             return Unmatched
         }
     }
@@ -309,24 +1251,176 @@ func (t *trie) find(element interface{}, sctx *searchContext) searchResult {
     }
 }
 
+// findCompletions moves the provided search context to the root of the subtree containing the elements that match the
+// provided prefix. The return value will be false if no elements match the prefix.
+func (t *trie) findCompletions(prefix interface{}, sctx *searchContext) bool {
+    return t.moveToCompletions(prefix, t.operations.find(prefix, sctx), sctx)
+}
+
+// moveToCompletions moves the provided search context, which is positioned where the search for the provided prefix
+// ended with the provided search result, to the root of the subtree containing the elements that match the prefix. The
+// return value will be false if no elements match the prefix.
+func (t *trie) moveToCompletions(prefix interface{}, searchResult searchResult, sctx *searchContext) bool {
+    numDigits := t.digitizer.NumDigitsOf(prefix)
+    if t.digitizer.IsPrefixFree() {
+        numDigits--
+        if sctx.processedEndOfString(prefix) {
+            sctx.ascend()
+        }
+    }
+
+    return searchResult == Prefix || searchResult == Matched || sctx.branchPosition == numDigits
+}
+
+// numDigitsWithoutEndOf returns the number of digits in the provided element excluding the end of string character
+// (if any).
+func (t *trie) numDigitsWithoutEndOf(element interface{}) int {
+    if t.digitizer.IsPrefixFree() {
+        return t.digitizer.NumDigitsOf(element) - 1
+    }
+
+    return t.digitizer.NumDigitsOf(element)
+}
+
 func (t *trie) prepareSearch(sctx *searchContext) {
     sctx.pointer        = t.root
     sctx.digitizer      = t.digitizer
     sctx.branchPosition = 0
 }
 
+// cloneOfKind returns a clone of the trie of the same kind as the variant embedding it (see operations), along with the
+// trie embedded by the clone.
+func (t *trie) cloneOfKind() (Trie, *trie) {
+    clone := t.operations.(Trie).Clone().(Trie)
+
+    return clone, clone.(interface{ embedded() *trie }).embedded()
+}
+
+// embedded returns the trie, which is the trie embedded by each variant.
+func (t *trie) embedded() *trie {
+    return t
+}
+
+// replaceWith replaces the contents of the trie with the contents of the provided trie of the same kind, which must not
+// be used afterwards. The operations of the trie are retained, so that a variant embedding the trie remains in effect.
+func (t *trie) replaceWith(other *trie) {
+    operations := t.operations
+    *t = *other
+    t.operations = operations
+}
+
+// retainAllWithPrefix removes all elements in the trie that do not match the provided prefix. Every element is removed
+// if the prefix is not accepted by the Digitizer of the trie.
+func (t *trie) retainAllWithPrefix(prefix interface{}) {
+    var first, last LeafNode
+    if !t.IsEmpty() && t.acceptsPrefix(prefix) {
+        sctx := acquireSearchContext()
+        defer releaseSearchContext(sctx)
+
+        if t.findCompletions(prefix, sctx) {
+            first, last = sctx.leafNodesInSubtree()
+        }
+    }
+
+    if first == nil {
+        t.Clear()
+        return
+    }
+
+    for leafNode := t.head.Next(); leafNode != first; {
+        next := leafNode.Next()
+        t.removeLeafNode(leafNode)
+        leafNode = next
+    }
+    for leafNode := last.Next(); !leafNode.IsTail(); {
+        next := leafNode.Next()
+        t.removeLeafNode(leafNode)
+        leafNode = next
+    }
+}
+
+// accepts returns true if the provided element is accepted by the Digitizer of the trie. Every method that digitizes an
+// element provided by the caller checks it with accepts (or acceptsPrefix) first, since the Digitizer is free to panic
+// on an element it does not accept.
+func (t *trie) accepts(element interface{}) bool {
+    return t.digitizer.Accepts(element) == nil
+}
+
+// acceptsPrefix returns true if the provided prefix is accepted by the Digitizer of the trie (see PrefixDigitizer).
+func (t *trie) acceptsPrefix(prefix interface{}) bool {
+    if digitizer, ok := t.digitizer.(PrefixDigitizer); ok {
+        return digitizer.AcceptsPrefix(prefix) == nil
+    }
+
+    return t.accepts(prefix)
+}
+
+// acceptsPattern returns true if the provided wildcard pattern (see Trie.WildcardMatch) is accepted by the Digitizer of
+// the trie. A string pattern that is not accepted as is has its wildcards replaced, where "?" is replaced by the first
+// character of the alphabet of the Digitizer and "*" is removed, and the resulting string is accepted as a prefix.
+func (t *trie) acceptsPattern(pattern interface{}) bool {
+    if t.acceptsPrefix(pattern) {
+        return true
+    }
+
+    str, ok  := pattern.(string)
+    alphabet := t.digitizer.Alphabet()
+    if !ok || len(alphabet) == 0 {
+        return false
+    }
+
+    return t.acceptsPrefix(strings.NewReplacer("?", string(alphabet[0]), "*", "").Replace(str))
+}
+
+// validate returns a non-nil error if the provided element is not accepted by the Digitizer of the trie, or if any of
+// its digits before the end of key digit is not a valid digit of the Digitizer (see Digitizer.IsValidDigit). Checking
+// the digits guards against Digitizers whose Accepts does not reject every character outside of their alphabet, since
+// such a digit would otherwise be placed in the wrong child (or beyond the children) of a node.
+func (t *trie) validate(element interface{}) error {
+    if err := t.digitizer.Accepts(element); err != nil {
+        return err
+    }
+
+    digits := batchDigitsOf(t.digitizer, element)
+    for place := 0; place < t.numDigitsWithoutEndOf(element); place++ {
+        digit := 0
+        if digits != nil {
+            digit = digits[place]
+        } else {
+            digit = t.digitizer.DigitOf(element, place)
+        }
+
+        if !t.digitizer.IsValidDigit(digit) {
+            return outsideAlphabet(element, place)
+        }
+    }
+
+    return nil
+}
+
 func (t *trie) insert(element interface{}) (Node, error) {
+    leafNode := t.operations.createLeafNode()
+    leafNode.SetValue(element)
+
+    return t.insertLeafNode(leafNode)
+}
+
+// insertLeafNode adds the provided leaf node to the trie, and links it into the iteration order.
+func (t *trie) insertLeafNode(leafNode LeafNode) (Node, error) {
+    element := leafNode.Value()
+    if err := t.validate(element); err != nil {
+        return nil, err
+    }
+
     sctx := acquireSearchContext()
     defer releaseSearchContext(sctx)
 
-    searchResult := t.find(element, sctx)
+    searchResult := t.operations.find(element, sctx)
     if searchResult == Matched || (!t.digitizer.IsPrefixFree() && (searchResult == Prefix || searchResult == Extension)) {
         return nil, errors.New(fmt.Sprintf( "element violates prefix-free requirement: %v", element))
     }
 
-    leafNode := newLeafNode()
-    leafNode.SetValue(element)
-    t.addNode(leafNode, sctx)
+    t.operations.addNode(leafNode, sctx)
     searchResult = Matched
 
     if t.moveToPredecessor(element, sctx, searchResult) {
@@ -340,9 +1434,51 @@ func (t *trie) insert(element interface{}) (Node, error) {
     return leafNode, nil
 }
 
+// insertAfter adds the provided element to the trie, where the provided previous leaf node (if any) is the leaf node of
+// the last element inserted by BulkAdd and the provided search context is positioned at it. The search context is left
+// at the leaf node of the element.
+func (t *trie) insertAfter(element interface{}, previous LeafNode, sctx *searchContext) (LeafNode, error) {
+    var searchResult searchResult
+    if previous == nil {
+        searchResult = t.operations.find(element, sctx)
+    } else {
+        commonPrefix := t.commonPrefixLength(element, previous.Value())
+        for sctx.atLeaf() || sctx.branchPosition > commonPrefix {
+            sctx.ascend()
+        }
+        searchResult = t.operations.resume(element, sctx)
+    }
+
+    if searchResult == Matched || (!t.digitizer.IsPrefixFree() && (searchResult == Prefix || searchResult == Extension)) {
+        return nil, errors.New(fmt.Sprintf( "element violates prefix-free requirement: %v", element))
+    }
+
+    leafNode := t.operations.createLeafNode()
+    leafNode.SetValue(element)
+    t.operations.addNode(leafNode, sctx)
+
+    if previous != nil && (previous.Next().IsTail() || t.digitizer.Compare(previous.Next().Value(), element) > 0) {
+        leafNode.AddAfter(previous)
+    } else {
+        pctx := acquireSearchContext()
+        defer releaseSearchContext(pctx)
+
+        *pctx = *sctx
+        if t.moveToPredecessor(element, pctx, Matched) {
+            leafNode.AddAfter(pctx.pointer.(LeafNode))
+        } else {
+            leafNode.AddAfter(t.head)
+        }
+    }
+
+    t.size++
+
+    return leafNode, nil
+}
+
 func (t *trie) addNode(node Node, sctx *searchContext) {
     if sctx.pointer == nil {
-        t.root = newRootNode(t.capacity)
+        t.root = t.createRootNode()
         sctx.pointer = t.root
     }
 
@@ -350,7 +1486,7 @@ func (t *trie) addNode(node Node, sctx *searchContext) {
 
     for sctx.branchPosition < t.digitizer.NumDigitsOf(element) - 1 {
         index     := t.digitizer.DigitOf(element, sctx.branchPosition)
-        childNode := newNode(t.capacity)
+        childNode := t.createNode()
         sctx.pointer.AddChildWithIndexOf(index, childNode)
         sctx.pointer = childNode
         sctx.branchPosition++
@@ -368,16 +1504,117 @@ func (t *trie) remove(node Node) {
     }
 
     element := node.Value()
-    level   := t.digitizer.NumDigitsOf(element)
+    t.detach(node, element, t.digitizer.NumDigitsOf(element))
+
+    t.size--
+}
+
+// removeLeafNode removes the provided leaf node from the trie, and returns its element.
+func (t *trie) removeLeafNode(leafNode LeafNode) interface{} {
+    element := leafNode.Value()
+    t.operations.remove(leafNode)
+
+    return element
+}
 
-    for !node.IsRoot() && !node.HasChildren() {
+// detach removes the provided node, located at the provided level along the path of the provided element, from its
+// parent along with any ancestors that are left without children. The removed nodes without children are released to
+// the node pool.
+func (t *trie) detach(node Node, element interface{}, level int) {
+    for !node.IsRoot() {
         parent := node.Parent()
         level--
         parent.RemoveChildWithIndexOf(t.digitizer.DigitOf(element, level))
+        if !node.HasChildren() {
+            releaseNode(node)
+        }
         node = parent
+
+        if node.HasChildren() {
+            break
+        }
+    }
+}
+
+// createNode returns an internal node for the trie, which is taken from the node pool unless the trie is sparse.
+func (t *trie) createNode() Node {
+    if t.sparse {
+        return newMapNode(t.capacity)
     }
 
-    t.size--
+    return acquireNode(t.capacity)
+}
+
+// createRootNode returns a new root node for the trie.
+func (t *trie) createRootNode() Node {
+    if t.sparse {
+        return newRootMapNode(t.capacity)
+    }
+
+    return newRootNode(t.capacity)
+}
+
+// createLeafNode returns a new leaf node for an element inserted into the trie.
+func (t *trie) createLeafNode() LeafNode {
+    return newLeafNode()
+}
+
+// ceiling returns the leaf node of the lowest element in the trie that is greater than or equal to the provided element.
+// If no such element exists, the tail is returned.
+func (t *trie) ceiling(element interface{}, sctx *searchContext) LeafNode {
+    searchResult := t.operations.find(element, sctx)
+    if searchResult == Matched {
+        return sctx.pointer.(LeafNode)
+    } else if t.moveToPredecessor(element, sctx, searchResult) {
+        return sctx.pointer.(LeafNode).Next()
+    }
+
+    return t.head.Next()
+}
+
+// sortByDigits returns a copy of the provided elements sorted by their digits. The digits of each element are computed
+// once up front, since the Digitizer is otherwise consulted for every comparison made by the sort.
+func (t *trie) sortByDigits(elements []interface{}) []interface{} {
+    digits := make([][]int, len(elements))
+    order  := make([]int, len(elements))
+    for i, element := range elements {
+        digits[i] = make([]int, t.digitizer.NumDigitsOf(element))
+        for place := range digits[i] {
+            digits[i][place] = t.digitizer.DigitOf(element, place)
+        }
+        order[i] = i
+    }
+
+    sort.Slice(order, func(i, j int) bool {
+        a, b := digits[order[i]], digits[order[j]]
+        for place := 0; place < len(a) && place < len(b); place++ {
+            if a[place] != b[place] {
+                return a[place] < b[place]
+            }
+        }
+
+        return len(a) < len(b)
+    })
+
+    sorted := make([]interface{}, len(elements))
+    for i, index := range order {
+        sorted[i] = elements[index]
+    }
+
+    return sorted
+}
+
+// commonPrefixLength returns the number of leading digits that the provided elements have in common.
+func (t *trie) commonPrefixLength(a interface{}, b interface{}) int {
+    numDigitsInA := t.digitizer.NumDigitsOf(a)
+    numDigitsInB := t.digitizer.NumDigitsOf(b)
+
+    place := 0
+    for place < numDigitsInA && place < numDigitsInB && t.digitizer.DigitOf(a, place) == t.digitizer.DigitOf(b, place) {
+        place++
+    }
+
+    return place
 }
 
 func (t *trie) moveToPredecessor(element interface{}, sctx *searchContext, searchResult searchResult) bool {
@@ -398,6 +1635,20 @@ func (t *trie) moveToPredecessor(element interface{}, sctx *searchContext, searc
     return true
 }
 
+// trieSnapshot is the encoded form of a trie. Data holds the data carried by the leaf node of each element in iteration
+// order, and is empty unless the trie is a dataCarrier.
+type trieSnapshot struct {
+    Base     int
+    Elements []interface{}
+    Data     []leafData
+}
+
+// leafData is the encoded form of the data carried by a leaf node. The data is wrapped so that nil data (e.g. a nil value
+// of a TrieMap) can be encoded, since encoding/gob rejects nil elements of a slice of interfaces.
+type leafData struct {
+    Value interface{}
+}
+
 type iterator struct {
     trie    *trie
     pointer LeafNode
@@ -423,14 +1674,15 @@ func (i *iterator) get() interface{} {
     return nil
 }
 
+// skipRemovedElements returns the first leaf node at or after the provided leaf node in the iteration order that has not
+// been removed from the trie. A removed leaf node retains its link to the leaf node that followed it, so a run of
+// removed leaf nodes is followed until a leaf node that is still in the trie (or the head or tail) is reached.
 func (i *iterator) skipRemovedElements(leafNode LeafNode) LeafNode {
-    if leafNode.IsHead() || leafNode.IsTail() || !leafNode.IsDeleted() {
-        return leafNode
+    for !leafNode.IsHead() && !leafNode.IsTail() && leafNode.IsDeleted() {
+        leafNode = leafNode.Next()
     }
 
-    leafNode.SetNext(i.skipRemovedElements(leafNode.Next()))
-
-    return leafNode.Next()
+    return leafNode
 }
 
 func (i *iterator) advance() bool {
@@ -448,6 +1700,10 @@ func (i *iterator) advance() bool {
 }
 
 func (i *iterator) retreat() bool {
+    if i.pointer.IsHead() {
+        return false
+    }
+
     if !i.pointer.IsTail() && !i.pointer.IsHead() && i.pointer.IsDeleted() {
         i.pointer = i.skipRemovedElements(i.pointer)
     }
@@ -458,15 +1714,62 @@ func (i *iterator) retreat() bool {
 }
 
 func (i *iterator) hasNext() bool {
-    if i.pointer.IsDeleted() {
-        i.skipRemovedElements(i.pointer)
+    next := i.pointer.Next()
+    if !i.pointer.IsHead() && i.pointer.IsDeleted() {
+        next = i.skipRemovedElements(i.pointer)
+    }
+
+    return !i.pointer.IsTail() && !next.IsTail()
+}
+
+// HasNext returns true if the traversal has further elements, otherwise false is returned.
+func (i *iterator) HasNext() bool {
+    return i.hasNext()
+}
+
+// Next advances the traversal and returns the next element. If the traversal has no further elements, the return value
+// will be nil.
+func (i *iterator) Next() interface{} {
+    if i.advance() {
+        return i.get()
     }
 
-    return !i.pointer.IsTail() && !i.pointer.Next().IsTail()
+    return nil
+}
+
+func (i *iterator) hasPrevious() bool {
+    previous := i.pointer
+    if !i.pointer.IsTail() && !i.pointer.IsHead() && i.pointer.IsDeleted() {
+        previous = i.skipRemovedElements(i.pointer)
+    }
+
+    return !i.pointer.IsHead() && !previous.Previous().IsHead()
 }
 
 func (i *iterator) remove() {
     if i.inCollection() {
-        i.trie.remove(i.pointer)
+        i.trie.operations.remove(i.pointer)
+    }
+}
+
+// reverseIterator traverses the elements of a trie from the last to the first. Removed leaf nodes no longer link to the
+// leaf node before them, so retreating from a removed leaf node first skips forward to a leaf node that is still in the
+// trie (see iterator.retreat).
+type reverseIterator struct {
+    *iterator
+}
+
+// HasNext returns true if the traversal has further elements, otherwise false is returned.
+func (i *reverseIterator) HasNext() bool {
+    return i.hasPrevious()
+}
+
+// Next retreats the traversal and returns the previous element in iteration order. If the traversal has no further
+// elements, the return value will be nil.
+func (i *reverseIterator) Next() interface{} {
+    if i.retreat() {
+        return i.get()
     }
-}
\ No newline at end of file
+
+    return nil
+}
@@ -0,0 +1,94 @@
+package trie
+
+import (
+    "fmt"
+    "testing"
+)
+
+// benchmarkSizes are the numbers of elements the benchmarks are run with, so that operations whose cost grows with the
+// size of the Trie (e.g. ValueWithIndex) are surfaced by the growth of their time per operation.
+var benchmarkSizes = []int{ 1000, 10000, 100000 }
+
+func BenchmarkTrie_Add(b *testing.B) {
+    words := randomWords(1000, 1)
+
+    for i := 0; i < b.N; i++ {
+        trie := NewTrie(26)
+        for _, word := range words {
+            _ = trie.Add(word)
+        }
+    }
+}
+
+func BenchmarkTrie_Contains(b *testing.B) {
+    benchmarkWithSizes(b, func(b *testing.B, trie Trie, words []interface{}) {
+        for i := 0; i < b.N; i++ {
+            trie.Contains(words[i % len(words)])
+        }
+    })
+}
+
+func BenchmarkTrie_Remove(b *testing.B) {
+    benchmarkWithSizes(b, func(b *testing.B, trie Trie, words []interface{}) {
+        for i := 0; i < b.N; i++ {
+            trie.Remove(words[i % len(words)])
+            _ = trie.Add(words[i % len(words)])
+        }
+    })
+}
+
+// BenchmarkTrie_AddRemove reports the allocations of a workload that repeatedly inserts and removes the same elements,
+// whose internal nodes are reused from the node pool rather than allocated for every insert.
+func BenchmarkTrie_AddRemove(b *testing.B) {
+    words := randomWords(1000, 1)
+    trie  := NewTrie(26)
+
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        word := words[i % len(words)]
+        _ = trie.Add(word)
+        trie.Remove(word)
+    }
+}
+
+func BenchmarkTrie_Values(b *testing.B) {
+    benchmarkWithSizes(b, func(b *testing.B, trie Trie, words []interface{}) {
+        for i := 0; i < b.N; i++ {
+            trie.Values()
+        }
+    })
+}
+
+// BenchmarkTrie_ValueWithIndex surfaces that ValueWithIndex is O(n), since the middle element is found by traversing
+// the elements from the first.
+func BenchmarkTrie_ValueWithIndex(b *testing.B) {
+    benchmarkWithSizes(b, func(b *testing.B, trie Trie, words []interface{}) {
+        for i := 0; i < b.N; i++ {
+            _, _ = trie.ValueWithIndex(len(words) / 2)
+        }
+    })
+}
+
+func BenchmarkTrie_Size(b *testing.B) {
+    benchmarkWithSizes(b, func(b *testing.B, trie Trie, words []interface{}) {
+        for i := 0; i < b.N; i++ {
+            trie.Size()
+        }
+    })
+}
+
+// benchmarkWithSizes runs the provided benchmark as a sub-benchmark for each of the benchmark sizes, with a Trie holding
+// that number of random words.
+func benchmarkWithSizes(b *testing.B, benchmark func(b *testing.B, trie Trie, words []interface{})) {
+    for _, n := range benchmarkSizes {
+        words := randomWords(n, 1)
+        trie  := NewTrie(26)
+        _ = trie.BulkAdd(words)
+
+        b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+            b.ResetTimer()
+            benchmark(b, trie, words)
+        })
+    }
+}
@@ -0,0 +1,171 @@
+package trie
+
+import "github.com/2speed/go-collection"
+
+// TrieMap defines the behavior for a Trie that associates a value with each of its elements, which serve as the keys of
+// the TrieMap. The Digitizer of the TrieMap digitizes the keys, so the accessors of the Trie (e.g. Completions,
+// Values, and Iterator) operate on the keys, while the values are accessed with Get and CompletionValues. A key added
+// with Add is associated with a nil value.
+type TrieMap interface {
+    Trie
+
+    // Put associates the provided value with the provided key in the TrieMap. If the key already exists in the TrieMap,
+    // its value is replaced with the provided value. The returned error will be non-nil if the key is not accepted by
+    // the Digitizer of the TrieMap.
+    Put(key interface{}, value interface{}) error
+
+    // Get returns the value associated with the provided key, and true if the key exists in the TrieMap. If the key is
+    // not found in the TrieMap, the return values will be nil and false.
+    Get(key interface{}) (interface{}, bool)
+
+    // Keys returns a slice containing the keys in the TrieMap in iteration order, which is equivalent to Values.
+    Keys() []interface{}
+
+    // CompletionValues finds all keys in the TrieMap that match the provided prefix, and appends the values associated
+    // with the matching keys (if any) to the provided collection in the iteration order of the keys.
+    CompletionValues(prefix interface{}, collection collection.Collection)
+}
+
+type trieMap struct {
+    *trie
+}
+
+// entryLeafNode is a LeafNode whose element is a key of a TrieMap, and that carries the value associated with the key.
+type entryLeafNode struct {
+    LeafNode
+
+    value interface{}
+}
+
+// NewTrieMap creates a new TrieMap with the provided capacity. The capacity is used to set the base (or range of
+// digits) used by the StringDigitizer for the keys of the trie.
+func NewTrieMap(capacity int) TrieMap {
+    return NewTrieMapWithDigitizer(NewStringDigitizer(capacity))
+}
+
+// NewTrieMapWithDigitizer creates a new TrieMap that uses the provided Digitizer for its keys.
+func NewTrieMapWithDigitizer(digitizer Digitizer) TrieMap {
+    tm := &trieMap{ trie: newTrieWithDigitizer(digitizer) }
+    tm.operations = tm
+
+    return tm
+}
+
+// Put associates the provided value with the provided key in the TrieMap. If the key already exists in the TrieMap, its
+// value is replaced with the provided value, and the key is left unchanged.
+func (tm *trieMap) Put(key interface{}, value interface{}) error {
+    if leafNode := tm.leafNodeOf(key); leafNode != nil {
+        leafNode.value = value
+
+        return nil
+    }
+
+    _, err := tm.insertLeafNode(newEntryLeafNode(key, value))
+
+    return err
+}
+
+// Get returns the value associated with the provided key, and true if the key exists in the TrieMap.
+func (tm *trieMap) Get(key interface{}) (interface{}, bool) {
+    if leafNode := tm.leafNodeOf(key); leafNode != nil {
+        return leafNode.value, true
+    }
+
+    return nil, false
+}
+
+// Keys returns a slice containing the keys in the TrieMap in iteration order.
+func (tm *trieMap) Keys() []interface{} {
+    return tm.Values()
+}
+
+// CompletionValues finds all keys in the TrieMap that match the provided prefix, and appends the values associated with
+// the matching keys (if any) to the provided collection. The matching keys are traversed along the leaf nodes.
+func (tm *trieMap) CompletionValues(prefix interface{}, collection collection.Collection) {
+    if tm.IsEmpty() || !tm.acceptsPrefix(prefix) {
+        return
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    if !tm.findCompletions(prefix, sctx) {
+        return
+    }
+
+    first, last := sctx.leafNodesInSubtree()
+    for leafNode := first; ; leafNode = leafNode.Next() {
+        _ = collection.Add(entryValueOf(leafNode))
+
+        if leafNode == last {
+            break
+        }
+    }
+}
+
+// Clone returns a new TrieMap with the same Digitizer containing the keys of the TrieMap along with their values.
+func (tm *trieMap) Clone() collection.Collection {
+    clone    := NewTrieMapWithDigitizer(tm.digitizer).(*trieMap)
+    iterator := newIterator(tm.trie, tm.head)
+
+    clone.sparse = tm.sparse
+    for iterator.advance() {
+        _, _ = clone.insertLeafNode(newEntryLeafNode(iterator.get(), entryValueOf(iterator.pointer)))
+    }
+
+    return clone
+}
+
+// createLeafNode returns a new leaf node with a nil value for a key inserted into the TrieMap (e.g. via Add).
+func (tm *trieMap) createLeafNode() LeafNode {
+    return &entryLeafNode{ LeafNode: newLeafNode() }
+}
+
+// dataOf returns the value carried by the provided leaf node, which is encoded along with its key.
+func (tm *trieMap) dataOf(leafNode LeafNode) interface{} {
+    return entryValueOf(leafNode)
+}
+
+// leafNodeWith returns a new leaf node for the provided key with the provided decoded value.
+func (tm *trieMap) leafNodeWith(key interface{}, data interface{}) (LeafNode, error) {
+    return newEntryLeafNode(key, data), nil
+}
+
+// leafNodeOf returns the leaf node of the provided key, or nil if the key is not found in the TrieMap.
+func (tm *trieMap) leafNodeOf(key interface{}) *entryLeafNode {
+    if tm.IsEmpty() || !tm.accepts(key) {
+        return nil
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    if tm.operations.find(key, sctx) == Matched {
+        if leafNode, ok := sctx.pointer.(*entryLeafNode); ok {
+            return leafNode
+        }
+    }
+
+    return nil
+}
+
+func newEntryLeafNode(key interface{}, value interface{}) *entryLeafNode {
+    leafNode := &entryLeafNode{ LeafNode: newLeafNode(), value: value }
+    leafNode.SetValue(key)
+
+    return leafNode
+}
+
+// AddAfter links the entryLeafNode into the iteration order after the provided leaf node.
+func (l *entryLeafNode) AddAfter(leafNode LeafNode) {
+    linkAfter(l, leafNode)
+}
+
+// entryValueOf returns the value carried by the provided leaf node, or nil if the leaf node does not carry a value.
+func entryValueOf(leafNode LeafNode) interface{} {
+    if entry, ok := leafNode.(*entryLeafNode); ok {
+        return entry.value
+    }
+
+    return nil
+}
@@ -0,0 +1,135 @@
+package trie
+
+import (
+    "bytes"
+    "encoding/gob"
+    "fmt"
+    "testing"
+
+    "github.com/2speed/go-collection/list"
+)
+
+func TestTrieMap_PutGet(t *testing.T) {
+    trie := NewTrieMap(26)
+
+    for key, value := range map[string]int{ "car": 1, "card": 2, "care": 3, "cat": 4, "dog": 5 } {
+        assertError(t, trie.Put(key, value), nil)
+    }
+    assertSize(t, trie, 5)
+
+    for _, c := range []struct {
+        key      string
+        expected interface{}
+        ok       bool
+    }{
+        { key: "car",  expected: 1,   ok: true },
+        { key: "Care", expected: 3,   ok: true },
+        { key: "dog",  expected: 5,   ok: true },
+        { key: "ca",   expected: nil, ok: false },
+        { key: "cart", expected: nil, ok: false },
+    } {
+        if actual, ok := trie.Get(c.key); actual != c.expected || ok != c.ok {
+            t.Errorf("Get('%s'): expected '%v, %t', but found '%v, %t'", c.key, c.expected, c.ok, actual, ok)
+        }
+    }
+
+    t.Run("Overwrite", func(t *testing.T) {
+        assertError(t, trie.Put("card", 20), nil)
+        assertSize(t, trie, 5)
+
+        if actual, _ := trie.Get("card"); actual != 20 {
+            t.Errorf("expected value of '20', but found '%v'", actual)
+        }
+    })
+
+    t.Run("Add", func(t *testing.T) {
+        assertError(t, trie.Add("cow"), nil)
+
+        if actual, ok := trie.Get("cow"); actual != nil || !ok {
+            t.Errorf("expected '<nil>, true', but found '%v, %t'", actual, ok)
+        }
+    })
+
+    t.Run("Remove", func(t *testing.T) {
+        trie.Remove("cow")
+
+        if _, ok := trie.Get("cow"); ok {
+            t.Error("expected key 'cow' to be removed")
+        }
+    })
+}
+
+func TestTrieMap_Keys(t *testing.T) {
+    trie := NewTrieMap(26)
+    for i, key := range []string{ "dog", "care", "car", "cat", "card" } {
+        _ = trie.Put(key, i)
+    }
+
+    if actual := fmt.Sprintf("%v", trie.Keys()); actual != "[car card care cat dog]" {
+        t.Errorf("expected '[car card care cat dog]', but found '%s'", actual)
+    }
+
+    for _, c := range []struct {
+        prefix       string
+        keys, values string
+    }{
+        { prefix: "car", keys: "[car, card, care]",      values: "[2, 4, 1]" },
+        { prefix: "ca",  keys: "[car, card, care, cat]", values: "[2, 4, 1, 3]" },
+        { prefix: "d",   keys: "[dog]",                  values: "[0]" },
+        { prefix: "cow", keys: "[]",                     values: "[]" },
+    } {
+        keys, values := list.NewArrayList(), list.NewArrayList()
+        trie.Completions(c.prefix, keys)
+        trie.CompletionValues(c.prefix, values)
+
+        assertContentEquals(t, keys, c.keys)
+        assertContentEquals(t, values, c.values)
+    }
+}
+
+func TestTrieMap_Clone(t *testing.T) {
+    source := NewTrieMap(26)
+    _ = source.Put("ant", 1)
+    _ = source.Put("ape", 2)
+
+    clone := source.Clone().(TrieMap)
+    _ = source.Put("ant", 10)
+    source.Remove("ape")
+
+    assertContentEquals(t, clone, "[ant, ape]")
+    if actual, _ := clone.Get("ant"); actual != 1 {
+        t.Errorf("expected value of '1', but found '%v'", actual)
+    }
+
+    if actual, _ := clone.Get("ape"); actual != 2 {
+        t.Errorf("expected value of '2', but found '%v'", actual)
+    }
+}
+
+func TestTrieMap_MarshalBinary(t *testing.T) {
+    source := NewTrieMap(26)
+    _ = source.Put("abc", 7)
+    _ = source.Put("abd", "seven")
+    _ = source.Add("bcd")
+
+    data, err := source.MarshalBinary()
+    assertError(t, err, nil)
+
+    target := NewTrieMap(26)
+    assertError(t, target.UnmarshalBinary(data), nil)
+    assertContentEquals(t, target, "[abc, abd, bcd]")
+    for key, expected := range map[string]interface{}{ "abc": 7, "abd": "seven", "bcd": nil } {
+        if actual, ok := target.Get(key); !ok || actual != expected {
+            t.Errorf("expected value of '%v' for '%s', but found '%v' (%v)", expected, key, actual, ok)
+        }
+    }
+
+    var buffer bytes.Buffer
+    assertError(t, gob.NewEncoder(&buffer).Encode(source), nil)
+
+    decoded := NewTrieMap(26)
+    assertError(t, gob.NewDecoder(&buffer).Decode(decoded), nil)
+    if actual, _ := decoded.Get("abc"); actual != 7 {
+        t.Errorf("expected value of '7', but found '%v'", actual)
+    }
+}
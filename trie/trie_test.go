@@ -1,7 +1,10 @@
 package trie
 
 import (
+    "bytes"
+    "encoding/gob"
     "fmt"
+    "math/rand"
     "reflect"
     "testing"
 
@@ -66,6 +69,198 @@ func TestTrie_AddAll(t *testing.T) {
     assertContentEquals(t, trie, "[brown, fox, quick, the]")
 }
 
+func TestTrie_AddAllRollback(t *testing.T) {
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(4)
+            err  := trie.AddAll(list.NewArrayListOf([]interface{}{ "ab", "dab" }))
+
+            assertError(t, err, nil)
+            tree := trie.TreeString()
+
+            for _, batch := range [][]interface{}{
+                { "ba", "cab", "ab" },
+                { "ba", "cab", 42 },
+                { "ba", "ba" },
+            } {
+                if err := trie.AddAll(list.NewArrayListOf(batch)); err == nil {
+                    t.Errorf("expected error when adding '%v'", batch)
+                }
+
+                assertSize(t, trie, 2)
+                assertContentEquals(t, trie, "[ab, dab]")
+                if actual := trie.TreeString(); actual != tree {
+                    t.Errorf("expected tree of\n%s\nbut found\n%s", tree, actual)
+                }
+            }
+        })
+    }
+}
+
+func TestTrie_BulkAdd(t *testing.T) {
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(26)
+            err  := trie.AddAll(list.NewArrayListOf([]interface{}{ "fox", "lazy" }))
+
+            assertError(t, err, nil)
+
+            err = trie.BulkAdd([]interface{}{ "the", "quick", "brown", "jumps", "over", "dog", "qu" })
+
+            assertError(t, err, nil)
+            assertSize(t, trie, 9)
+            assertContentEquals(t, trie, "[brown, dog, fox, jumps, lazy, over, qu, quick, the]")
+
+            for _, batch := range [][]interface{}{
+                { "cat", "ant", "fox" },
+                { "cat", "ant", 42 },
+                { "cat", "cat" },
+            } {
+                if err := trie.BulkAdd(batch); err == nil {
+                    t.Errorf("expected error when adding '%v'", batch)
+                }
+
+                assertSize(t, trie, 9)
+                assertContentEquals(t, trie, "[brown, dog, fox, jumps, lazy, over, qu, quick, the]")
+            }
+        })
+    }
+}
+
+func TestTrie_BulkAddEquivalence(t *testing.T) {
+    words := randomWords(2000, 42)
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            expected := newTrie(26)
+            actual   := newTrie(26)
+
+            assertError(t, expected.AddAll(list.NewArrayListOf(words)), nil)
+            assertError(t, actual.AddAll(list.NewArrayListOf(words[:500])), nil)
+            assertError(t, actual.BulkAdd(words[500:]), nil)
+
+            if !reflect.DeepEqual(actual.Values(), expected.Values()) {
+                t.Errorf("expected values of BulkAdd to equal values of AddAll")
+            }
+            if actual.TreeString() != expected.TreeString() {
+                t.Errorf("expected tree of BulkAdd to equal tree of AddAll")
+            }
+        })
+    }
+}
+
+func BenchmarkTrie_AddAll(b *testing.B) {
+    words := list.NewArrayListOf(randomWords(50000, 1))
+
+    for i := 0; i < b.N; i++ {
+        _ = NewTrie(26).AddAll(words)
+    }
+}
+
+func BenchmarkTrie_BulkAdd(b *testing.B) {
+    words := randomWords(50000, 1)
+
+    for i := 0; i < b.N; i++ {
+        _ = NewTrie(26).BulkAdd(words)
+    }
+}
+
+func TestTrie_AddWithPosition(t *testing.T) {
+    for name, trie := range map[string]Trie{
+        "Trie":         NewTrie(26),
+        "RadixTree":    NewRadixTree(26),
+        "CountingTrie": NewCountingTrie(26),
+        "BoundedTrie":  NewBoundedTrie(26, 6),
+    } {
+        for _, c := range []struct {
+            element  string
+            expected int
+        }{
+            { element: "mario", expected: 0 },
+            { element: "yoshi", expected: 1 },
+            { element: "kirby", expected: 0 },
+            { element: "samus", expected: 2 },
+            { element: "luigi", expected: 1 },
+            { element: "zelda", expected: 5 },
+        } {
+            position, err := trie.AddWithPosition(c.element)
+            assertError(t, err, nil)
+
+            if position != c.expected {
+                t.Errorf("%s: expected '%s' at position %d, but found %d", name, c.element, c.expected, position)
+            }
+
+            if actual, _ := trie.ValueWithIndex(position); actual != c.element {
+                t.Errorf("%s: expected '%s' at position %d, but found '%v'", name, c.element, position, actual)
+            }
+        }
+
+        if position, err := trie.AddWithPosition("link!"); err == nil || position != collection.ElementNotFound {
+            t.Errorf("%s: expected an error and position %d, but found '%d, %v'", name, collection.ElementNotFound, position, err)
+        }
+    }
+
+    counting := NewCountingTrie(26)
+    _ = counting.AddAll(list.NewArrayListOf([]interface{}{ "kirby", "mario" }))
+    if position, err := counting.AddWithPosition("mario"); err != nil || position != 1 || counting.Count("mario") != 2 {
+        t.Errorf("expected another occurrence of 'mario' at position 1, but found '%d, %v'", position, err)
+    }
+
+    bounded := NewBoundedTrie(26, 1)
+    _ = bounded.Add("kirby")
+    if position, err := bounded.AddWithPosition("mario"); err != collection.ErrorCapacityReached || position != collection.ElementNotFound {
+        t.Errorf("expected '%v', but found '%d, %v'", collection.ErrorCapacityReached, position, err)
+    }
+}
+
+func TestTrie_FromCollection(t *testing.T) {
+    source := NewTrie(26)
+    err    := source.AddAll(list.NewArrayListOf([]interface{}{ "the", "quick", "brown", "fox" }))
+
+    assertError(t, err, nil)
+
+    elements := list.NewArrayListFrom(source)
+    assertContentEquals(t, elements, "[brown, fox, quick, the]")
+
+    trie, err := FromCollection(elements, 26)
+    assertError(t, err, nil)
+    assertContentEquals(t, trie, "[brown, fox, quick, the]")
+
+    if trie, err := FromCollection(list.NewArrayListOf([]interface{}{ "fox", 42 }), 26); err == nil || trie != nil {
+        t.Errorf("expected error and nil trie, but found '%v', '%v'", err, trie)
+    }
+}
+
+func TestTrie_ContainsPrefixElement(t *testing.T) {
+    for name, trie := range map[string]Trie{
+        "Trie":       NewTrie(26),
+        "SparseTrie": NewSparseTrieWithDigitizer(NewStringDigitizer(26)),
+        "RadixTree":  NewRadixTree(26),
+        "ByteTrie":   NewTrieWithDigitizer(NewByteDigitizer()),
+        "RuneRadix":  NewRadixTreeWithDigitizer(NewRuneDigitizer()),
+    } {
+        for _, values := range [][]interface{}{ { "da", "dab" }, { "dab", "da" }, { "", "d", "da", "dab", "dabb" } } {
+            trie.Clear()
+            assertError(t, trie.AddAll(list.NewArrayListOf(values)), nil)
+
+            for _, v := range trie.Values() {
+                if !trie.Contains(v) {
+                    t.Errorf("%s %v: expected to contain '%v'", name, values, v)
+                }
+            }
+
+            if trie.Contains("dx") || trie.Contains("dabx") {
+                t.Errorf("%s %v: expected not to contain 'dx' or 'dabx'", name, values)
+            }
+        }
+
+        trie.Remove("dab")
+        if !trie.Contains("da") || !trie.Contains("dabb") || trie.Contains("dab") {
+            t.Errorf("%s: expected to contain 'da' and 'dabb' but not 'dab' after removal", name)
+        }
+    }
+}
+
 func TestTrie_Remove(t *testing.T) {
     trie   := NewTrie(26)
     values := []interface{}{ "jumped", "over", "the", "lazy", "dog" }
@@ -105,6 +300,74 @@ func TestTrie_MinMax(t *testing.T) {
     assertNodeValue(t, trie.Max(), "cba")
 }
 
+func TestTrie_MinMaxIndex(t *testing.T) {
+    values := []interface{}{ "cba", "ab", "bce", "abcd" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        trie := newTrie(5)
+
+        if trie.MinIndex() != collection.ElementNotFound || trie.MaxIndex() != collection.ElementNotFound {
+            t.Errorf("%s: expected no positions for an empty trie, but found %d and %d", name, trie.MinIndex(), trie.MaxIndex())
+        }
+
+        _ = trie.AddAll(list.NewArrayListOf(values))
+
+        if actual, err := trie.ValueWithIndex(trie.MinIndex()); err != nil || actual != trie.Min() {
+            t.Errorf("%s: expected '%v' at MinIndex, but found '%v' (%v)", name, trie.Min(), actual, err)
+        }
+
+        if actual, err := trie.ValueWithIndex(trie.MaxIndex()); err != nil || actual != trie.Max() {
+            t.Errorf("%s: expected '%v' at MaxIndex, but found '%v' (%v)", name, trie.Max(), actual, err)
+        }
+
+        if _, err := trie.ValueWithIndex(trie.MaxIndex() + 1); err == nil {
+            t.Errorf("%s: expected error for index %d", name, trie.MaxIndex() + 1)
+        }
+    }
+}
+
+func TestTrie_RemoveMinMax(t *testing.T) {
+    values := []interface{}{ "dab", "ab", "dabb", "bac", "daca", "dac", "dabba" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(4)
+            if trie.RemoveMin() != nil || trie.RemoveMax() != nil {
+                t.Error("expected nil when removing from an empty trie")
+            }
+
+            _ = trie.AddAll(list.NewArrayListOf(values))
+            ascending := make([]interface{}, 0)
+            for !trie.IsEmpty() {
+                ascending = append(ascending, trie.RemoveMin())
+            }
+
+            if actual := fmt.Sprintf("%v", ascending); actual != "[ab bac dab dabb dabba dac daca]" {
+                t.Errorf("expected '[ab bac dab dabb dabba dac daca]', but found '%s'", actual)
+            }
+
+            _ = trie.AddAll(list.NewArrayListOf(values))
+            descending := make([]interface{}, 0)
+            for !trie.IsEmpty() {
+                descending = append(descending, trie.RemoveMax())
+                assertContains(t, trie, descending[len(descending) - 1].(string), false)
+            }
+
+            if actual := fmt.Sprintf("%v", descending); actual != "[daca dac dabba dabb dab bac ab]" {
+                t.Errorf("expected '[daca dac dabba dabb dab bac ab]', but found '%s'", actual)
+            }
+
+            _ = trie.AddAll(list.NewArrayListOf(values))
+            assertNodeValue(t, trie.RemoveMin(), "ab")
+            assertNodeValue(t, trie.RemoveMax(), "daca")
+            assertSize(t, trie, 5)
+            assertContentEquals(t, trie, "[bac, dab, dabb, dabba, dac]")
+            assertContains(t, trie, "ab", false)
+            assertContains(t, trie, "dac", true)
+        })
+    }
+}
+
 func TestTrie_Predecessor(t *testing.T) {
     trie   := NewTrie(4)
     values := []interface{}{ "bac", "dab", "dabb", "dac", "daca", "dabba", "ab" }
@@ -127,6 +390,77 @@ func TestTrie_Successor(t *testing.T) {
     assertContentEquals(t, trie, "[ab, bac, dab, dabb, dabba, dac, daca]")
     assertNodeValue(t, trie.Successor("dabba"), "dac")
     assertNodeValue(t, trie.Successor("bac"), "dab")
+    assertNodeValue(t, trie.Successor("c"), "dab")
+    assertNodeValue(t, trie.Successor("dabc"), "dac")
+    assertNodeValue(t, trie.Successor("a"), "ab")
+
+    if successor := trie.Successor("daca"); successor != nil {
+        t.Errorf("expected no successor of the last element, but found '%v'", successor)
+    }
+}
+
+func TestTrie_PredecessorSuccessorFunc(t *testing.T) {
+    trie   := NewTrie(26)
+    values := []interface{}{ "foo", "Goo", "hoo" }
+    err    := trie.AddAll(list.NewArrayListOf(values))
+    less   := func(a, b interface{}) bool { return a.(string) < b.(string) }
+
+    assertError(t, err, nil)
+    assertContentEquals(t, trie, "[foo, Goo, hoo]")
+
+    t.Run("Default", func(t *testing.T) {
+        assertNodeValue(t, trie.SuccessorFunc("Foo", nil), "Goo")
+        assertNodeValue(t, trie.PredecessorFunc("goo", nil), "foo")
+    })
+
+    t.Run("Comparator", func(t *testing.T) {
+        assertNodeValue(t, trie.SuccessorFunc("Foo", less), "foo")
+        assertNodeValue(t, trie.SuccessorFunc("foo", less), "Goo")
+        assertNodeValue(t, trie.PredecessorFunc("goo", less), "Goo")
+        assertNodeValue(t, trie.PredecessorFunc("Goo", less), "foo")
+        assertNodeValue(t, trie.SuccessorFunc("g", less), "Goo")
+        assertNodeValue(t, trie.PredecessorFunc("g", less), "foo")
+
+        if successor := trie.SuccessorFunc("hoo", less); successor != nil {
+            t.Errorf("expected no successor of the last element, but found '%v'", successor)
+        }
+
+        if predecessor := trie.PredecessorFunc("Foo", less); predecessor != nil {
+            t.Errorf("expected no predecessor of the first element, but found '%v'", predecessor)
+        }
+    })
+}
+
+func TestTrie_FloorCeiling(t *testing.T) {
+    values := []interface{}{ "bac", "dab", "dabb", "dac", "daca", "dabba", "ab" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(4)
+            err  := trie.AddAll(list.NewArrayListOf(values))
+
+            assertError(t, err, nil)
+
+            for _, c := range []struct {
+                name     string
+                actual   interface{}
+                expected interface{}
+            }{
+                { name: "Floor",          actual: trie.Floor("dabb"),   expected: "dabb" },
+                { name: "Floor,Absent",   actual: trie.Floor("dabc"),   expected: "dabba" },
+                { name: "Floor,Prefix",   actual: trie.Floor("da"),     expected: "bac" },
+                { name: "Floor,Min",      actual: trie.Floor("aa"),     expected: nil },
+                { name: "Ceiling",        actual: trie.Ceiling("dabb"), expected: "dabb" },
+                { name: "Ceiling,Absent", actual: trie.Ceiling("dabc"), expected: "dac" },
+                { name: "Ceiling,Prefix", actual: trie.Ceiling("da"),   expected: "dab" },
+                { name: "Ceiling,Max",    actual: trie.Ceiling("dad"),  expected: nil },
+            } {
+                if c.actual != c.expected {
+                    t.Errorf("%s: expected '%v', but found '%v'", c.name, c.expected, c.actual)
+                }
+            }
+        })
+    }
 }
 
 func TestTrie_Completions(t *testing.T) {
@@ -147,6 +481,568 @@ func TestTrie_Completions(t *testing.T) {
     assertContentEquals(t, l, "[da, dabc, daca]")
 }
 
+func TestTrie_CountCompletions(t *testing.T) {
+    trie   := NewTrie(4)
+    values := []interface{}{ "acb", "dabc", "daca", "da", "ab" }
+    err    := trie.AddAll(list.NewArrayListOf(values))
+
+    assertError(t, err, nil)
+
+    for prefix, expected := range map[string]int{ "a": 2, "da": 3, "dab": 1, "d": 3, "c": 0, "dd": 0, "": 5 } {
+        if actual := trie.CountCompletions(prefix); actual != expected {
+            t.Errorf("expected %d completions of '%s', but found %d", expected, prefix, actual)
+        }
+    }
+
+    if actual := NewTrie(4).CountCompletions("a"); actual != 0 {
+        t.Errorf("expected 0 completions for an empty trie, but found %d", actual)
+    }
+
+    for name, trie := range map[string]Trie{ "Trie": trie, "RadixTree": NewRadixTree(4) } {
+        _ = trie.AddAll(list.NewArrayListOf(values))
+
+        for _, prefix := range []string{ "", "a", "ab", "acb", "d", "da", "dab", "dac", "daca", "b", "dd" } {
+            l := list.NewArrayList()
+            trie.Completions(prefix, l)
+
+            if actual := trie.CountCompletions(prefix); actual != l.Size() {
+                t.Errorf("%s: expected %d completions of '%s', but found %d", name, l.Size(), prefix, actual)
+            }
+        }
+    }
+}
+
+func TestTrie_CompletionsLimit(t *testing.T) {
+    values := []interface{}{ "dab", "ab", "dabb", "bac", "daca", "dac", "dabba", "dad" }
+
+    for name, trie := range map[string]Trie{ "Trie": NewTrie(4), "RadixTree": NewRadixTree(4) } {
+        _ = trie.AddAll(list.NewArrayListOf(values))
+
+        for _, c := range []struct {
+            prefix   string
+            limit    int
+            expected string
+        }{
+            { prefix: "da",  limit: 3,  expected: "[dab, dabb, dabba]" },
+            { prefix: "da",  limit: 5,  expected: "[dab, dabb, dabba, dac, daca]" },
+            { prefix: "da",  limit: 10, expected: "[dab, dabb, dabba, dac, daca, dad]" },
+            { prefix: "",    limit: 2,  expected: "[ab, bac]" },
+            { prefix: "dab", limit: 1,  expected: "[dab]" },
+            { prefix: "dab", limit: 0,  expected: "[]" },
+            { prefix: "dd",  limit: 3,  expected: "[]" },
+        } {
+            l := list.NewArrayList()
+            trie.CompletionsLimit(c.prefix, c.limit, l)
+
+            if actual := fmt.Sprintf("%v", l); actual != c.expected {
+                t.Errorf("%s: expected '%s' for CompletionsLimit('%s', %d), but found '%s'", name, c.expected, c.prefix, c.limit, actual)
+            }
+        }
+    }
+}
+
+func TestTrie_HasPrefix(t *testing.T) {
+    for name, trie := range map[string]Trie{ "Trie": NewTrie(4), "RadixTree": NewRadixTree(4) } {
+        t.Run(name, func(t *testing.T) {
+            err := trie.AddAll(list.NewArrayListOf([]interface{}{ "acb", "dabc", "daca", "ab" }))
+
+            assertError(t, err, nil)
+            assertContains(t, trie, "da", false)
+
+            for prefix, expected := range map[string]bool{ "a": true, "da": true, "dab": true, "daca": true, "dad": false, "c": false, "abc": false } {
+                if actual := trie.HasPrefix(prefix); actual != expected {
+                    t.Errorf("expected HasPrefix('%s') to be %v", prefix, expected)
+                }
+            }
+        })
+    }
+
+    if NewTrie(4).HasPrefix("a") {
+        t.Error("expected result to be false for an empty trie")
+    }
+}
+
+func TestTrie_Lookup(t *testing.T) {
+    for name, trie := range map[string]Trie{ "Trie": NewTrie(4), "RadixTree": NewRadixTree(4) } {
+        _ = trie.AddAll(list.NewArrayListOf([]interface{}{ "dab", "dabb", "dac", "ab" }))
+
+        for _, c := range []struct {
+            element         string
+            exact, isPrefix bool
+        }{
+            { element: "dab",  exact: true,  isPrefix: true },
+            { element: "ab",   exact: true,  isPrefix: false },
+            { element: "da",   exact: false, isPrefix: true },
+            { element: "dad",  exact: false, isPrefix: false },
+            { element: "dabb", exact: true,  isPrefix: false },
+            { element: "",     exact: false, isPrefix: true },
+            { element: "ab!",  exact: false, isPrefix: false },
+        } {
+            exact, isPrefix := trie.Lookup(c.element)
+            if exact != c.exact || isPrefix != c.isPrefix {
+                t.Errorf("%s: expected Lookup('%s') to be '%t, %t', but found '%t, %t'", name, c.element, c.exact, c.isPrefix, exact, isPrefix)
+            }
+
+            if exact != trie.Contains(c.element) {
+                t.Errorf("%s: expected Lookup('%s') to agree with Contains", name, c.element)
+            }
+        }
+    }
+}
+
+func TestTrie_LongestPrefixOf(t *testing.T) {
+    for name, trie := range map[string]Trie{ "Trie": NewTrie(26), "RadixTree": NewRadixTree(26) } {
+        _ = trie.AddAll(list.NewArrayListOf([]interface{}{ "api", "apiv", "apivtwo", "apps", "blog" }))
+
+        for _, c := range []struct {
+            query    string
+            expected interface{}
+            ok       bool
+        }{
+            { query: "apivtwousers", expected: "apivtwo", ok: true },
+            { query: "apivone",      expected: "apiv",    ok: true },
+            { query: "apix",         expected: "api",     ok: true },
+            { query: "apiv",         expected: "apiv",    ok: true },
+            { query: "blogpost",     expected: "blog",    ok: true },
+            { query: "ap",           expected: nil,       ok: false },
+            { query: "app",          expected: nil,       ok: false },
+            { query: "shop",         expected: nil,       ok: false },
+            { query: "api!",         expected: nil,       ok: false },
+        } {
+            if actual, ok := trie.LongestPrefixOf(c.query); actual != c.expected || ok != c.ok {
+                t.Errorf("%s: expected LongestPrefixOf('%s') to be '%v, %t', but found '%v, %t'", name, c.query, c.expected, c.ok, actual, ok)
+            }
+        }
+    }
+
+    if _, ok := NewTrie(26).LongestPrefixOf("api"); ok {
+        t.Error("expected no prefix in an empty trie")
+    }
+}
+
+func TestTrie_RemoveAllWithPrefix(t *testing.T) {
+    values := []interface{}{ "acb", "dabc", "daca", "da", "ab", "dd", "dac" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(4)
+            err  := trie.AddAll(list.NewArrayListOf(values))
+
+            assertError(t, err, nil)
+            assertContentEquals(t, trie, "[ab, acb, da, dabc, dac, daca, dd]")
+
+            iterator := trie.Iterator()
+            assertNodeValue(t, iterator.Next(), "ab")
+            assertNodeValue(t, iterator.Next(), "acb")
+
+            if count := trie.RemoveAllWithPrefix("da"); count != 4 {
+                t.Errorf("expected 4 elements removed, but found %d", count)
+            }
+
+            assertSize(t, trie, 3)
+            assertContentEquals(t, trie, "[ab, acb, dd]")
+            assertContains(t, trie, "dac", false)
+            assertNodeValue(t, iterator.Next(), "dd")
+            assertNodeValue(t, trie.Successor("acb"), "dd")
+            assertNodeValue(t, trie.Predecessor("dd"), "acb")
+
+            if count := trie.RemoveAllWithPrefix("c"); count != 0 {
+                t.Errorf("expected 0 elements removed, but found %d", count)
+            }
+
+            err = trie.Add("dab")
+            assertError(t, err, nil)
+            assertContentEquals(t, trie, "[ab, acb, dab, dd]")
+
+            if count := trie.RemoveAllWithPrefix(""); count != 4 {
+                t.Errorf("expected 4 elements removed, but found %d", count)
+            }
+            assertSize(t, trie, 0)
+            assertContentEquals(t, trie, "[]")
+
+            err = trie.Add("bad")
+            assertError(t, err, nil)
+            assertContentEquals(t, trie, "[bad]")
+        })
+    }
+}
+
+func TestTrie_Split(t *testing.T) {
+    values := []interface{}{ "acb", "dabc", "daca", "da", "ab", "dd", "dac" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(4)
+            _ = trie.AddAll(list.NewArrayListOf(values))
+
+            for _, c := range []struct {
+                prefix         string
+                matching, rest string
+            }{
+                { prefix: "da", matching: "[da, dabc, dac, daca]",                 rest: "[ab, acb, dd]" },
+                { prefix: "a",  matching: "[ab, acb]",                             rest: "[da, dabc, dac, daca, dd]" },
+                { prefix: "c",  matching: "[]",                                    rest: "[ab, acb, da, dabc, dac, daca, dd]" },
+                { prefix: "",   matching: "[ab, acb, da, dabc, dac, daca, dd]",    rest: "[]" },
+            } {
+                matching, rest := trie.Split(c.prefix)
+                assertContentEquals(t, matching, c.matching)
+                assertContentEquals(t, rest, c.rest)
+
+                err := matching.AddAll(rest)
+                assertError(t, err, nil)
+                if !collection.Equals(matching, trie) {
+                    t.Errorf("expected the split on '%s' to recombine to '%s', but found '%s'", c.prefix, trie, matching)
+                }
+            }
+
+            assertSize(t, trie, len(values))
+            assertContentEquals(t, trie, "[ab, acb, da, dabc, dac, daca, dd]")
+        })
+    }
+
+    matching, rest := NewTrie(4).Split("da")
+    assertSize(t, matching, 0)
+    assertSize(t, rest, 0)
+
+    radixTree := NewRadixTree(4)
+    _ = radixTree.AddAll(list.NewArrayListOf(values))
+    for _, prefix := range []interface{}{ "da", "de", 5 } {
+        matching, rest := radixTree.Split(prefix)
+        if expected := fmt.Sprintf("%T", radixTree); fmt.Sprintf("%T", matching) != expected || fmt.Sprintf("%T", rest) != expected {
+            t.Errorf("expected the split on '%v' to return two '%s', but found '%T' and '%T'", prefix, expected, matching, rest)
+        }
+
+        if err := matching.AddAll(rest); err != nil || !collection.Equals(matching, radixTree) {
+            t.Errorf("expected the split on '%v' to recombine to '%s', but found '%s'", prefix, radixTree, matching)
+        }
+    }
+
+    matching, rest = radixTree.Split("de")
+    assertSize(t, matching, 0)
+    assertContentEquals(t, rest, "[ab, acb, da, dabc, dac, daca, dd]")
+
+    counting := NewCountingTrie(4)
+    _ = counting.AddAll(list.NewArrayListOf([]interface{}{ "da", "da", "dd", "ab" }))
+    matching, rest = counting.Split("d")
+    if count := matching.(CountingTrie).Count("da"); count != 2 {
+        t.Errorf("expected the count of 'da' to be '2', but found '%d'", count)
+    }
+    assertContentEquals(t, matching, "[da, dd]")
+    assertContentEquals(t, rest, "[ab]")
+}
+
+func TestTrie_RejectedInput(t *testing.T) {
+    byLength := func(a, b interface{}) bool { return len(a.(string)) < len(b.(string)) }
+    collected := func(query func(collection collection.Collection)) interface{} {
+        completions := list.NewArrayList()
+        query(completions)
+
+        return completions.Size()
+    }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(4)
+            _ = trie.AddAll(list.NewArrayListOf([]interface{}{ "ab", "acb", "da", "dabc" }))
+
+            for _, input := range []interface{}{ "e", "dae", 5 } {
+                exact, isPrefix := trie.Lookup(input)
+                longest, found  := trie.LongestPrefixOf(input)
+
+                for _, c := range []struct {
+                    method   string
+                    actual   interface{}
+                    expected interface{}
+                }{
+                    { method: "Contains",            actual: trie.Contains(input),                                      expected: false },
+                    { method: "Remove",              actual: trie.Remove(input),                                        expected: false },
+                    { method: "Lookup",              actual: exact || isPrefix,                                         expected: false },
+                    { method: "LongestPrefixOf",     actual: longest != nil || found,                                   expected: false },
+                    { method: "Predecessor",         actual: trie.Predecessor(input),                                   expected: nil },
+                    { method: "Successor",           actual: trie.Successor(input),                                     expected: nil },
+                    { method: "Completions",         actual: collected(func(c collection.Collection) { trie.Completions(input, c) }),              expected: 0 },
+                    { method: "LongestCommonPrefix", actual: collected(func(c collection.Collection) { trie.LongestCommonPrefix(input, c) }),      expected: 0 },
+                    { method: "ApproximateMatch",    actual: collected(func(c collection.Collection) { trie.ApproximateMatch(input, 1, c) }),      expected: 0 },
+                    { method: "WildcardMatch",       actual: collected(func(c collection.Collection) { trie.WildcardMatch(input, c) }),            expected: 0 },
+                    { method: "PredecessorFunc",     actual: trie.PredecessorFunc(input, byLength),                     expected: nil },
+                    { method: "SuccessorFunc",       actual: trie.SuccessorFunc(input, byLength),                       expected: nil },
+                    { method: "CompletionsLimit",    actual: collected(func(c collection.Collection) { trie.CompletionsLimit(input, 2, c) }),      expected: 0 },
+                    { method: "Floor",               actual: trie.Floor(input),                                         expected: nil },
+                    { method: "Ceiling",             actual: trie.Ceiling(input),                                       expected: nil },
+                    { method: "Range,Low",           actual: collected(func(c collection.Collection) { trie.Range(input, "dd", c) }),              expected: 0 },
+                    { method: "Range,High",          actual: collected(func(c collection.Collection) { trie.Range("a", input, c) }),               expected: 0 },
+                    { method: "RemoveAllWithPrefix", actual: trie.RemoveAllWithPrefix(input),                           expected: 0 },
+                    { method: "CountCompletions",    actual: trie.CountCompletions(input),                              expected: 0 },
+                    { method: "HasPrefix",           actual: trie.HasPrefix(input),                                     expected: false },
+                } {
+                    if c.actual != c.expected {
+                        t.Errorf("%s(%v): expected '%v', but found '%v'", c.method, input, c.expected, c.actual)
+                    }
+                }
+            }
+            assertSize(t, trie, 4)
+        })
+    }
+}
+
+func TestTrie_Merge(t *testing.T) {
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie  := newTrie(26)
+            other := newTrie(26)
+            _ = trie.BulkAdd([]interface{}{ "samus", "luffy" })
+            _ = other.BulkAdd([]interface{}{ "yoshi", "kirby", "sam" })
+
+            assertError(t, trie.Merge(other), nil)
+            assertSize(t, trie, 5)
+            assertContentEquals(t, trie, "[kirby, luffy, sam, samus, yoshi]")
+            assertContentEquals(t, other, "[kirby, sam, yoshi]")
+
+            overlapping := newTrie(26)
+            _ = overlapping.BulkAdd([]interface{}{ "samus", "mario", "kirby", "ness" })
+
+            assertError(t, trie.Merge(overlapping), nil)
+            assertSize(t, trie, 7)
+            assertContentEquals(t, trie, "[kirby, luffy, mario, ness, sam, samus, yoshi]")
+
+            assertError(t, trie.Merge(nil), nil)
+            assertSize(t, trie, 7)
+        })
+    }
+
+    t.Run("BaseMismatch", func(t *testing.T) {
+        trie  := NewTrie(26)
+        other := NewTrie(4)
+        _ = other.Add("abc")
+
+        if err := trie.Merge(other); err == nil {
+            t.Error("expected error when merging a trie whose digitizer has a different base")
+        }
+        assertSize(t, trie, 0)
+    })
+
+    t.Run("Bounded", func(t *testing.T) {
+        trie  := NewBoundedTrie(26, 3)
+        other := NewTrie(26)
+        _ = trie.Add("samus")
+        _ = other.BulkAdd([]interface{}{ "samus", "luffy", "yoshi", "kirby" })
+
+        assertError(t, trie.Merge(other), collection.ErrorCapacityReached)
+        assertContentEquals(t, trie, "[samus]")
+
+        other.Remove("kirby")
+        assertError(t, trie.Merge(other), nil)
+        assertContentEquals(t, trie, "[luffy, samus, yoshi]")
+    })
+}
+
+func TestTrie_ApproximateMatch(t *testing.T) {
+    values := []interface{}{ "cat", "car", "cart", "dog", "dot", "cut", "at", "scatter" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(26)
+            err  := trie.AddAll(list.NewArrayListOf(values))
+
+            assertError(t, err, nil)
+
+            for _, c := range []struct {
+                query       string
+                maxDistance int
+                expected    string
+            }{
+                { query: "cat",  maxDistance: 0, expected: "[cat]" },
+                { query: "cta",  maxDistance: 0, expected: "[]" },
+                { query: "cat",  maxDistance: 1, expected: "[at, car, cart, cat, cut]" },
+                { query: "dig",  maxDistance: 1, expected: "[dog]" },
+                { query: "dig",  maxDistance: 2, expected: "[dog, dot]" },
+                { query: "scat", maxDistance: 1, expected: "[cat]" },
+                { query: "xyz",  maxDistance: 2, expected: "[]" },
+                { query: "",     maxDistance: 2, expected: "[at]" },
+            } {
+                l := list.NewArrayList()
+                trie.ApproximateMatch(c.query, c.maxDistance, l)
+
+                if actual := fmt.Sprintf("%s", l); actual != c.expected {
+                    t.Errorf("ApproximateMatch('%s', %d): expected '%s', but found '%s'", c.query, c.maxDistance, c.expected, actual)
+                }
+            }
+        })
+    }
+}
+
+func TestTrie_WildcardMatch(t *testing.T) {
+    values := []interface{}{ "acb", "dabc", "daca", "da", "ab" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(4)
+            err  := trie.AddAll(list.NewArrayListOf(values))
+
+            assertError(t, err, nil)
+
+            for pattern, expected := range map[string]string{
+                "a?b":  "[acb]",
+                "d*":   "[da, dabc, daca]",
+                "*a":   "[da, daca]",
+                "?b":   "[ab]",
+                "*":    "[ab, acb, da, dabc, daca]",
+                "da?a": "[daca]",
+                "*c*":  "[acb, dabc, daca]",
+                "d**c": "[dabc]",
+                "a?":   "[ab]",
+                "???":  "[acb]",
+                "b*":   "[]",
+                "":     "[]",
+                "e*":   "[]",
+                "d?e":  "[]",
+            } {
+                l := list.NewArrayList()
+                trie.WildcardMatch(pattern, l)
+
+                if actual := fmt.Sprintf("%s", l); actual != expected {
+                    t.Errorf("WildcardMatch('%s'): expected '%s', but found '%s'", pattern, expected, actual)
+                }
+            }
+        })
+    }
+}
+
+func TestTrie_ContainsAll(t *testing.T) {
+    values := []interface{}{ "acb", "dabc", "daca", "da", "ab" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(4)
+            err  := trie.AddAll(list.NewArrayListOf(values))
+
+            assertError(t, err, nil)
+
+            for _, c := range []struct {
+                name     string
+                other    collection.Collection
+                expected bool
+            }{
+                { name: "Subset",   other: list.NewArrayListOf([]interface{}{ "da", "acb" }),              expected: true },
+                { name: "Equal",    other: list.NewArrayListOf(values),                                    expected: true },
+                { name: "Superset", other: list.NewArrayListOf(append([]interface{}{ "dab" }, values...)), expected: false },
+                { name: "Disjoint", other: list.NewArrayListOf([]interface{}{ "d", "abc" }),               expected: false },
+                { name: "Empty",    other: list.NewArrayList(),                                            expected: true },
+                { name: "Nil",      other: nil,                                                            expected: true },
+            } {
+                if actual := trie.ContainsAll(c.other); actual != c.expected {
+                    t.Errorf("%s: expected '%t', but found '%t'", c.name, c.expected, actual)
+                }
+            }
+        })
+    }
+}
+
+func TestTrie_ContainsAllOf(t *testing.T) {
+    values := []interface{}{ "acb", "dabc", "daca", "da", "ab" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(4)
+            err  := trie.AddAll(list.NewArrayListOf(values))
+
+            assertError(t, err, nil)
+
+            actual   := trie.ContainsAllOf([]interface{}{ "da", "dab", "acb", "abc", 42, "da" })
+            expected := map[interface{}]bool{ "da": true, "dab": false, "acb": true, "abc": false, 42: false }
+            if !reflect.DeepEqual(actual, expected) {
+                t.Errorf("expected '%v', but found '%v'", expected, actual)
+            }
+
+            trie.Clear()
+            if actual := trie.ContainsAllOf([]interface{}{ "da" }); actual["da"] {
+                t.Errorf("expected empty trie not to contain 'da', but found '%v'", actual)
+            }
+        })
+    }
+}
+
+func TestTrie_RemoveAll(t *testing.T) {
+    values := []interface{}{ "acb", "dabc", "daca", "da", "ab" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(4)
+            err  := trie.AddAll(list.NewArrayListOf(values))
+
+            assertError(t, err, nil)
+
+            if count := trie.RemoveAll(list.NewArrayListOf([]interface{}{ "da", "ab", "dab", 42 })); count != 2 {
+                t.Errorf("expected '2' elements removed, but found '%d'", count)
+            }
+
+            assertSize(t, trie, 3)
+            assertContentEquals(t, trie, "[acb, dabc, daca]")
+
+            if count := trie.RemoveAll(nil); count != 0 {
+                t.Errorf("expected '0' elements removed, but found '%d'", count)
+            }
+        })
+    }
+}
+
+func TestTrie_RetainAll(t *testing.T) {
+    values := []interface{}{ "acb", "dabc", "daca", "da", "ab" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(4)
+            err  := trie.AddAll(list.NewArrayListOf(values))
+
+            assertError(t, err, nil)
+
+            other := list.NewArrayListOf([]interface{}{ "da", "ab", "dab", "bad" })
+            if count := trie.RetainAll(other); count != 3 {
+                t.Errorf("expected '3' elements removed, but found '%d'", count)
+            }
+
+            assertSize(t, trie, 2)
+            assertContentEquals(t, trie, "[ab, da]")
+            assertContentEquals(t, other, "[da, ab, dab, bad]")
+        })
+    }
+}
+
+func TestTrie_Range(t *testing.T) {
+    values := []interface{}{ "acb", "dabc", "daca", "da", "ab", "bd" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(4)
+            err  := trie.AddAll(list.NewArrayListOf(values))
+
+            assertError(t, err, nil)
+
+            for _, c := range []struct {
+                low      string
+                high     string
+                expected string
+            }{
+                { low: "ab",   high: "da",   expected: "[ab, acb, bd]" },
+                { low: "aa",   high: "dab",  expected: "[ab, acb, bd, da]" },
+                { low: "b",    high: "dabc", expected: "[bd, da]" },
+                { low: "dabc", high: "dd",   expected: "[dabc, daca]" },
+                { low: "a",    high: "ddd",  expected: "[ab, acb, bd, da, dabc, daca]" },
+                { low: "c",    high: "cd",   expected: "[]" },
+                { low: "da",   high: "da",   expected: "[]" },
+                { low: "dd",   high: "a",    expected: "[]" },
+            } {
+                l := list.NewArrayList()
+                trie.Range(c.low, c.high, l)
+
+                if actual := fmt.Sprintf("%s", l); actual != c.expected {
+                    t.Errorf("Range('%s', '%s'): expected '%s', but found '%s'", c.low, c.high, c.expected, actual)
+                }
+            }
+        })
+    }
+}
+
 func TestTrie_LongestCommonPrefix(t *testing.T) {
     trie   := NewTrie(4)
     values := []interface{}{ "acb", "dadc", "dada", "da", "ab" }
@@ -165,6 +1061,391 @@ func TestTrie_LongestCommonPrefix(t *testing.T) {
     assertContentEquals(t, l, "[dada, dadc]")
 }
 
+func TestTrie_Iterator(t *testing.T) {
+    trie   := NewTrie(26)
+    values := []interface{}{ "jumped", "over", "the", "lazy", "dog" }
+    err    := trie.AddAll(list.NewArrayListOf(values))
+
+    assertError(t, err, nil)
+
+    t.Run("Values", func(t *testing.T) {
+        expected := trie.Values()
+        iterator := trie.Iterator()
+        for i := 0; iterator.HasNext(); i++ {
+            if actual := iterator.Next(); actual != expected[i] {
+                t.Errorf("expected '%v' at position %d, but found '%v'", expected[i], i, actual)
+            }
+        }
+
+        if iterator.Next() != nil {
+            t.Error("expected nil after the last element")
+        }
+    })
+
+    t.Run("SkipRemoved", func(t *testing.T) {
+        iterator := trie.Iterator()
+        assertNodeValue(t, iterator.Next(), "dog")
+
+        trie.Remove("dog")
+        trie.Remove("jumped")
+        trie.Remove("lazy")
+
+        actual := list.NewArrayList()
+        for iterator.HasNext() {
+            _ = actual.Add(iterator.Next())
+        }
+        assertContentEquals(t, actual, "[over, the]")
+    })
+
+    t.Run("SkipRemovedRun", func(t *testing.T) {
+        trie   := NewSparseRadixTreeWithDigitizer(NewIntDigitizer())
+        values := make([]interface{}, 50000)
+        for i := range values {
+            values[i] = i
+        }
+        assertError(t, trie.BulkAdd(values), nil)
+
+        iterator := trie.Iterator()
+        if actual := iterator.Next(); actual != 0 {
+            t.Fatalf("expected '0', but found '%v'", actual)
+        }
+
+        for i := 0; i < len(values) - 1; i++ {
+            trie.Remove(i)
+        }
+
+        if !iterator.HasNext() {
+            t.Fatal("expected the last element after the removed run")
+        }
+        if actual := iterator.Next(); actual != len(values) - 1 {
+            t.Errorf("expected '%d', but found '%v'", len(values) - 1, actual)
+        }
+        if iterator.HasNext() {
+            t.Error("expected no elements after the last element")
+        }
+    })
+
+    t.Run("Variants", func(t *testing.T) {
+        for name, trie := range map[string]Trie{
+            "RadixTree":    NewRadixTree(26),
+            "WeightedTrie": NewWeightedTrie(26),
+            "CountingTrie": NewCountingTrie(26),
+        } {
+            _ = trie.AddAll(list.NewArrayListOf(values))
+
+            actual   := make([]interface{}, 0)
+            iterator := trie.Iterator()
+            for iterator.HasNext() {
+                actual = append(actual, iterator.Next())
+            }
+
+            if !reflect.DeepEqual(actual, trie.Values()) {
+                t.Errorf("%s: expected '%v', but found '%v'", name, trie.Values(), actual)
+            }
+        }
+    })
+}
+
+func TestTrie_ReverseIterator(t *testing.T) {
+    values := []interface{}{ "jumped", "over", "the", "lazy", "dog" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            trie := newTrie(26)
+            assertError(t, trie.AddAll(list.NewArrayListOf(values)), nil)
+
+            ascending := trie.Values()
+            expected  := make([]interface{}, 0, len(ascending))
+            for i := len(ascending) - 1; i >= 0; i-- {
+                expected = append(expected, ascending[i])
+            }
+
+            if actual := trie.ValuesDescending(); !reflect.DeepEqual(actual, expected) {
+                t.Errorf("expected '%v', but found '%v'", expected, actual)
+            }
+
+            actual   := make([]interface{}, 0)
+            iterator := trie.ReverseIterator()
+            for iterator.HasNext() {
+                actual = append(actual, iterator.Next())
+            }
+
+            if !reflect.DeepEqual(actual, expected) {
+                t.Errorf("expected '%v', but found '%v'", expected, actual)
+            }
+            if iterator.Next() != nil {
+                t.Error("expected nil after the first element")
+            }
+        })
+    }
+
+    t.Run("SkipRemoved", func(t *testing.T) {
+        trie := NewTrie(26)
+        _ = trie.AddAll(list.NewArrayListOf(values))
+
+        iterator := trie.ReverseIterator()
+        assertNodeValue(t, iterator.Next(), "the")
+        assertNodeValue(t, iterator.Next(), "over")
+
+        trie.Remove("over")
+        trie.Remove("lazy")
+        trie.Remove("jumped")
+
+        actual := list.NewArrayList()
+        for iterator.HasNext() {
+            _ = actual.Add(iterator.Next())
+        }
+        assertContentEquals(t, actual, "[dog]")
+        assertContentEquals(t, list.NewArrayListOf(trie.ValuesDescending()), "[the, dog]")
+    })
+
+    t.Run("Empty", func(t *testing.T) {
+        trie := NewTrie(26)
+        if trie.ReverseIterator().HasNext() || len(trie.ValuesDescending()) != 0 {
+            t.Error("expected no elements in an empty trie")
+        }
+    })
+}
+
+func TestTrie_Stream(t *testing.T) {
+    values := []interface{}{ "bac", "dab", "dabb", "dac", "daca", "dabba", "ab" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        trie := newTrie(4)
+        _ = trie.AddAll(list.NewArrayListOf(values))
+        trie.Remove("dac")
+
+        streamed := make([]interface{}, 0)
+        for element := range trie.Stream() {
+            streamed = append(streamed, element)
+        }
+
+        if !reflect.DeepEqual(streamed, trie.Values()) {
+            t.Errorf("%s: expected streamed elements to equal Values(), but found '%v'", name, streamed)
+        }
+    }
+}
+
+func TestTrie_Walk(t *testing.T) {
+    trie   := NewTrie(26)
+    values := []interface{}{ "jumped", "over", "the", "lazy", "dog" }
+    err    := trie.AddAll(list.NewArrayListOf(values))
+
+    assertError(t, err, nil)
+
+    t.Run("Halt", func(t *testing.T) {
+        visited := list.NewArrayList()
+        trie.Walk(func(element interface{}) bool {
+            _ = visited.Add(element)
+            return visited.Size() < 2
+        })
+        assertContentEquals(t, visited, "[dog, jumped]")
+    })
+
+    t.Run("All", func(t *testing.T) {
+        visited := list.NewArrayList()
+        trie.Walk(func(element interface{}) bool {
+            _ = visited.Add(element)
+            return true
+        })
+        assertContentEquals(t, visited, "[dog, jumped, lazy, over, the]")
+    })
+}
+
+func TestTrie_MarshalBinary(t *testing.T) {
+    values := []interface{}{ "bac", "dab", "dabb", "dac", "daca", "dabba", "ab" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            source := newTrie(4)
+            err    := source.AddAll(list.NewArrayListOf(values))
+
+            assertError(t, err, nil)
+
+            data, err := source.MarshalBinary()
+            assertError(t, err, nil)
+
+            target := newTrie(4)
+            _ = target.Add("cab")
+
+            err = target.UnmarshalBinary(data)
+            assertError(t, err, nil)
+
+            if !reflect.DeepEqual(target.Values(), source.Values()) {
+                t.Errorf("expected values '%v', but found '%v'", source.Values(), target.Values())
+            }
+            assertNodeValue(t, target.Successor("dabba"), "dac")
+
+            if err := newTrie(26).UnmarshalBinary(data); err == nil {
+                t.Error("expected error for mismatched digitizer base")
+            }
+
+            other := NewTrieWithDigitizer(NewIntDigitizer())
+            _ = other.Add(42)
+            data, err = other.MarshalBinary()
+            assertError(t, err, nil)
+
+            // a capacity of 16 has the same base as an IntDigitizer, so the elements are only rejected on insertion
+            target = newTrie(16)
+            _ = target.Add("cab")
+
+            if err := target.UnmarshalBinary(data); err == nil {
+                t.Error("expected error for elements not accepted by the digitizer")
+            }
+            assertContentEquals(t, target, "[cab]")
+        })
+    }
+}
+
+func TestTrie_Gob(t *testing.T) {
+    values := []interface{}{ "bac", "dab", "dabb", "dac", "daca", "dabba", "ab" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            source := newTrie(4)
+            err    := source.AddAll(list.NewArrayListOf(values))
+
+            assertError(t, err, nil)
+
+            var buffer bytes.Buffer
+            assertError(t, gob.NewEncoder(&buffer).Encode(source), nil)
+
+            target := newTrie(4)
+            assertError(t, gob.NewDecoder(&buffer).Decode(target), nil)
+
+            if !reflect.DeepEqual(target.Values(), source.Values()) {
+                t.Errorf("expected values '%v', but found '%v'", source.Values(), target.Values())
+            }
+            assertNodeValue(t, target.Predecessor("dac"), "dabba")
+        })
+    }
+}
+
+func TestTrie_TreeString(t *testing.T) {
+    for _, c := range []struct {
+        name     string
+        trie     Trie
+        expected string
+    }{
+        {
+            name:     "Trie",
+            trie:     NewTrie(4),
+            expected: ".\n" +
+                      "+-- a\n" +
+                      "|   `-- b\n" +
+                      "|       +-- # (ab)\n" +
+                      "|       `-- c\n" +
+                      "|           `-- # (abc)\n" +
+                      "`-- b\n" +
+                      "    `-- a\n" +
+                      "        `-- # (ba)",
+        },
+        {
+            name:     "RadixTree",
+            trie:     NewRadixTree(4),
+            expected: ".\n" +
+                      "+-- a\n" +
+                      "|   `-- b\n" +
+                      "|       +-- # (ab)\n" +
+                      "|       `-- c (abc)\n" +
+                      "`-- b (ba)",
+        },
+    } {
+        t.Run(c.name, func(t *testing.T) {
+            if actual := c.trie.TreeString(); actual != "." {
+                t.Errorf("expected '.' for an empty trie, but found '%s'", actual)
+            }
+
+            err := c.trie.AddAll(list.NewArrayListOf([]interface{}{ "ab", "abc", "ba" }))
+            assertError(t, err, nil)
+
+            if actual := c.trie.TreeString(); actual != c.expected {
+                t.Errorf("expected tree of\n%s\nbut found\n%s", c.expected, actual)
+            }
+        })
+    }
+}
+
+func TestTrie_Clone(t *testing.T) {
+    values := []interface{}{ "jumped", "over", "the", "lazy", "dog" }
+
+    for name, newTrie := range map[string]func(int) Trie{ "Trie": NewTrie, "RadixTree": NewRadixTree } {
+        t.Run(name, func(t *testing.T) {
+            source := newTrie(26)
+            err    := source.AddAll(list.NewArrayListOf(values))
+
+            assertError(t, err, nil)
+
+            clone := source.Clone()
+            if reflect.TypeOf(clone) != reflect.TypeOf(source) {
+                t.Errorf("expected clone of type '%T', but found '%T'", source, clone)
+            }
+            assertContentEquals(t, clone, "[dog, jumped, lazy, over, the]")
+
+            _ = clone.Add("fox")
+            clone.Remove("lazy")
+
+            assertSize(t, source, 5)
+            assertContains(t, source, "fox", false)
+            assertContains(t, source, "lazy", true)
+            assertContentEquals(t, source, "[dog, jumped, lazy, over, the]")
+            assertContentEquals(t, clone, "[dog, fox, jumped, over, the]")
+        })
+    }
+}
+
+func TestTrie_Height(t *testing.T) {
+    values := []interface{}{ "ab", "abcd", "acb", "cbca" }
+
+    for _, c := range []struct {
+        name         string
+        trie         Trie
+        height       int
+        averageDepth float64
+    }{
+        { name: "Trie",      trie: NewTrie(4),      height: 5, averageDepth: 4.25 },
+        { name: "RadixTree", trie: NewRadixTree(4), height: 3, averageDepth: 2.25 },
+    } {
+        t.Run(c.name, func(t *testing.T) {
+            if c.trie.Height() != 0 || c.trie.AverageDepth() != 0 {
+                t.Error("expected height and average depth of 0 for an empty trie")
+            }
+
+            err := c.trie.AddAll(list.NewArrayListOf(values))
+            assertError(t, err, nil)
+
+            if actual := c.trie.Height(); actual != c.height {
+                t.Errorf("expected height of '%d', but found '%d'", c.height, actual)
+            }
+
+            if actual := c.trie.AverageDepth(); actual != c.averageDepth {
+                t.Errorf("expected average depth of '%v', but found '%v'", c.averageDepth, actual)
+            }
+        })
+    }
+}
+
+// randomWords returns the provided number of distinct lower case words generated from the provided seed.
+func randomWords(n int, seed int64) []interface{} {
+    random := rand.New(rand.NewSource(seed))
+    seen   := make(map[string]bool, n)
+    words  := make([]interface{}, 0, n)
+
+    for len(words) < n {
+        word := make([]byte, 3 + random.Intn(8))
+        for i := range word {
+            word[i] = byte('a' + random.Intn(26))
+        }
+
+        if !seen[string(word)] {
+            seen[string(word)] = true
+            words = append(words, string(word))
+        }
+    }
+
+    return words
+}
+
 func assertError(t *testing.T, actual error, expected error) {
     t.Helper()
 
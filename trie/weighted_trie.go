@@ -0,0 +1,207 @@
+package trie
+
+import (
+    "github.com/2speed/go-collection"
+    "github.com/2speed/go-collection/queue"
+    "github.com/pkg/errors"
+)
+
+// WeightedTrie defines the behavior for a Trie that associates an integer weight with each of its elements, so that
+// completions may be ranked by weight (e.g. the frequency of a search term) rather than by iteration order.
+type WeightedTrie interface {
+    Trie
+
+    // AddWeighted inserts the provided element into the WeightedTrie with the provided weight. If the element already
+    // exists in the WeightedTrie, its weight is replaced with the provided weight. The returned error will be non-nil if
+    // the element is not accepted by the Digitizer of the WeightedTrie.
+    AddWeighted(element interface{}, weight int) error
+
+    // Weight returns the weight of the provided element. The returned error will be non-nil if the provided element is
+    // not found in the WeightedTrie.
+    Weight(element interface{}) (int, error)
+
+    // TopCompletions returns (at most) the k elements with the highest weights that match the provided prefix, ordered
+    // from highest to lowest weight. Elements with equal weights are ordered by iteration order.
+    TopCompletions(prefix interface{}, k int) []interface{}
+}
+
+type weightedTrie struct {
+    *trie
+}
+
+// weightedLeafNode is a LeafNode that carries the weight of its element.
+type weightedLeafNode struct {
+    LeafNode
+
+    weight int
+}
+
+// rankedElement is a candidate completion held in the bounded heap of TopCompletions. The rank is the position of the
+// element in iteration order, and is used to order elements with equal weights.
+type rankedElement struct {
+    element interface{}
+    weight  int
+    rank    int
+}
+
+// NewWeightedTrie creates a new WeightedTrie with the provided capacity. The capacity is used to set the base (or range
+// of digits) used by the StringDigitizer for the trie.
+func NewWeightedTrie(capacity int) WeightedTrie {
+    return NewWeightedTrieWithDigitizer(NewStringDigitizer(capacity))
+}
+
+// NewWeightedTrieWithDigitizer creates a new WeightedTrie that uses the provided Digitizer.
+func NewWeightedTrieWithDigitizer(digitizer Digitizer) WeightedTrie {
+    wt := &weightedTrie{ trie: newTrieWithDigitizer(digitizer) }
+    wt.operations = wt
+
+    return wt
+}
+
+// AddWeighted inserts the provided element into the WeightedTrie with the provided weight. If the element already exists
+// in the WeightedTrie, its weight is replaced with the provided weight.
+func (wt *weightedTrie) AddWeighted(element interface{}, weight int) error {
+    if leafNode := wt.leafNodeOf(element); leafNode != nil {
+        leafNode.weight = weight
+
+        return nil
+    }
+
+    _, err := wt.insertLeafNode(newWeightedLeafNode(element, weight))
+
+    return err
+}
+
+// Weight returns the weight of the provided element. The returned error will be non-nil if the provided element is not
+// found in the WeightedTrie.
+func (wt *weightedTrie) Weight(element interface{}) (int, error) {
+    if leafNode := wt.leafNodeOf(element); leafNode != nil {
+        return leafNode.weight, nil
+    }
+
+    return 0, collection.ErrorElementNotFound
+}
+
+// TopCompletions returns (at most) the k elements with the highest weights that match the provided prefix, ordered from
+// highest to lowest weight. The matching elements are traversed along the leaf nodes, while a heap bounded to k elements
+// retains the highest weighted elements seen so far.
+func (wt *weightedTrie) TopCompletions(prefix interface{}, k int) []interface{} {
+    completions := make([]interface{}, 0)
+    if wt.IsEmpty() || k <= 0 || !wt.acceptsPrefix(prefix) {
+        return completions
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    if !wt.findCompletions(prefix, sctx) {
+        return completions
+    }
+
+    heap        := queue.NewPriorityQueue(lowerRanked)
+    first, last := sctx.leafNodesInSubtree()
+    for leafNode, rank := first, 0; ; leafNode, rank = leafNode.Next(), rank + 1 {
+        _ = heap.Add(rankedElement{ element: leafNode.Value(), weight: weightOf(leafNode), rank: rank })
+        if heap.Size() > k {
+            heap.RemoveFirst()
+        }
+
+        if leafNode == last {
+            break
+        }
+    }
+
+    for !heap.IsEmpty() {
+        completions = append(completions, heap.RemoveFirst().(rankedElement).element)
+    }
+
+    for i, j := 0, len(completions) - 1; i < j; i, j = i + 1, j - 1 {
+        completions[i], completions[j] = completions[j], completions[i]
+    }
+
+    return completions
+}
+
+// Clone returns a new WeightedTrie with the same Digitizer containing the elements of the WeightedTrie along with their
+// weights.
+func (wt *weightedTrie) Clone() collection.Collection {
+    clone    := NewWeightedTrieWithDigitizer(wt.digitizer).(*weightedTrie)
+    iterator := newIterator(wt.trie, wt.head)
+
+    clone.sparse = wt.sparse
+    for iterator.advance() {
+        _, _ = clone.insertLeafNode(newWeightedLeafNode(iterator.get(), weightOf(iterator.pointer)))
+    }
+
+    return clone
+}
+
+// createLeafNode returns a new leaf node with a weight of 0 for an element inserted into the WeightedTrie (e.g. via Add).
+func (wt *weightedTrie) createLeafNode() LeafNode {
+    return &weightedLeafNode{ LeafNode: newLeafNode() }
+}
+
+// dataOf returns the weight of the provided leaf node, which is encoded along with its element.
+func (wt *weightedTrie) dataOf(leafNode LeafNode) interface{} {
+    return weightOf(leafNode)
+}
+
+// leafNodeWith returns a new leaf node for the provided element with the provided decoded weight. The returned error
+// will be non-nil if the weight is not an int.
+func (wt *weightedTrie) leafNodeWith(element interface{}, data interface{}) (LeafNode, error) {
+    weight, ok := data.(int)
+    if !ok {
+        return nil, errors.Errorf("decoded weight of type %T is not an int: %v", data, data)
+    }
+
+    return newWeightedLeafNode(element, weight), nil
+}
+
+// leafNodeOf returns the leaf node of the provided element, or nil if the element is not found in the WeightedTrie.
+func (wt *weightedTrie) leafNodeOf(element interface{}) *weightedLeafNode {
+    if wt.IsEmpty() || !wt.accepts(element) {
+        return nil
+    }
+
+    sctx := acquireSearchContext()
+    defer releaseSearchContext(sctx)
+
+    if wt.operations.find(element, sctx) == Matched {
+        if leafNode, ok := sctx.pointer.(*weightedLeafNode); ok {
+            return leafNode
+        }
+    }
+
+    return nil
+}
+
+func newWeightedLeafNode(element interface{}, weight int) *weightedLeafNode {
+    leafNode := &weightedLeafNode{ LeafNode: newLeafNode(), weight: weight }
+    leafNode.SetValue(element)
+
+    return leafNode
+}
+
+// AddAfter links the weightedLeafNode into the iteration order after the provided leaf node.
+func (l *weightedLeafNode) AddAfter(leafNode LeafNode) {
+    linkAfter(l, leafNode)
+}
+
+// weightOf returns the weight of the provided leaf node, or 0 if the leaf node does not carry a weight.
+func weightOf(leafNode LeafNode) int {
+    if weighted, ok := leafNode.(*weightedLeafNode); ok {
+        return weighted.weight
+    }
+
+    return 0
+}
+
+// lowerRanked returns true if ranked element a should be evicted from TopCompletions before ranked element b.
+func lowerRanked(a, b interface{}) bool {
+    x, y := a.(rankedElement), b.(rankedElement)
+    if x.weight != y.weight {
+        return x.weight < y.weight
+    }
+
+    return x.rank > y.rank
+}
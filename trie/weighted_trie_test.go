@@ -0,0 +1,107 @@
+package trie
+
+import (
+    "bytes"
+    "encoding/gob"
+    "fmt"
+    "testing"
+
+    "github.com/2speed/go-collection"
+)
+
+func TestWeightedTrie_TopCompletions(t *testing.T) {
+    trie := NewWeightedTrie(26)
+
+    for element, weight := range map[string]int{
+        "car":     40,
+        "card":    15,
+        "care":    70,
+        "careful": 25,
+        "cart":    15,
+        "cat":     90,
+        "dog":     100,
+    } {
+        assertError(t, trie.AddWeighted(element, weight), nil)
+    }
+    assertError(t, trie.Add("carp"), nil)
+    assertSize(t, trie, 8)
+
+    for _, c := range []struct {
+        prefix   string
+        k        int
+        expected string
+    }{
+        { prefix: "ca",  k: 3, expected: "[cat care car]" },
+        { prefix: "car", k: 4, expected: "[care car careful card]" },
+        { prefix: "car", k: 6, expected: "[care car careful card cart carp]" },
+        { prefix: "",    k: 2, expected: "[dog cat]" },
+        { prefix: "cat", k: 5, expected: "[cat]" },
+        { prefix: "cow", k: 5, expected: "[]" },
+        { prefix: "car", k: 0, expected: "[]" },
+    } {
+        if actual := fmt.Sprintf("%v", trie.TopCompletions(c.prefix, c.k)); actual != c.expected {
+            t.Errorf("TopCompletions('%s', %d): expected '%s', but found '%s'", c.prefix, c.k, c.expected, actual)
+        }
+    }
+
+    assertError(t, trie.AddWeighted("cart", 80), nil)
+    assertSize(t, trie, 8)
+
+    if actual := fmt.Sprintf("%v", trie.TopCompletions("car", 2)); actual != "[cart care]" {
+        t.Errorf("expected '[cart care]' after reweighting, but found '%s'", actual)
+    }
+
+    weight, err := trie.Weight("cart")
+    assertError(t, err, nil)
+    if weight != 80 {
+        t.Errorf("expected weight of '80', but found '%d'", weight)
+    }
+
+    _, err = trie.Weight("cab")
+    assertError(t, err, collection.ErrorElementNotFound)
+}
+
+func TestWeightedTrie_Clone(t *testing.T) {
+    source := NewWeightedTrie(26)
+    _ = source.AddWeighted("ant", 1)
+    _ = source.AddWeighted("ape", 3)
+    _ = source.AddWeighted("art", 2)
+
+    clone := source.Clone().(WeightedTrie)
+    source.Remove("ape")
+
+    assertContentEquals(t, clone, "[ant, ape, art]")
+    if actual := fmt.Sprintf("%v", clone.TopCompletions("a", 3)); actual != "[ape art ant]" {
+        t.Errorf("expected '[ape art ant]', but found '%s'", actual)
+    }
+}
+
+func TestWeightedTrie_MarshalBinary(t *testing.T) {
+    source := NewWeightedTrie(26)
+    _ = source.AddWeighted("ant", 1)
+    _ = source.AddWeighted("ape", 42)
+    _ = source.AddWeighted("art", 2)
+
+    data, err := source.MarshalBinary()
+    assertError(t, err, nil)
+
+    target := NewWeightedTrie(26)
+    assertError(t, target.UnmarshalBinary(data), nil)
+    assertContentEquals(t, target, "[ant, ape, art]")
+    if weight, err := target.Weight("ape"); err != nil || weight != 42 {
+        t.Errorf("expected weight of '42', but found '%d' (%v)", weight, err)
+    }
+
+    var buffer bytes.Buffer
+    assertError(t, gob.NewEncoder(&buffer).Encode(source), nil)
+
+    decoded := NewWeightedTrie(26)
+    assertError(t, gob.NewDecoder(&buffer).Decode(decoded), nil)
+    if actual := fmt.Sprintf("%v", decoded.TopCompletions("a", 3)); actual != "[ape art ant]" {
+        t.Errorf("expected '[ape art ant]', but found '%s'", actual)
+    }
+
+    trie := NewTrie(26)
+    assertError(t, trie.UnmarshalBinary(data), nil)
+    assertContentEquals(t, trie, "[ant, ape, art]")
+}